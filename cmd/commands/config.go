@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ramendr/ramenctl/pkg/config"
+	"github.com/ramendr/ramenctl/pkg/console"
+)
+
+var ConfigCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage configuration file",
+}
+
+var ConfigCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Check configuration file for problems",
+	Run: func(c *cobra.Command, args []string) {
+		issues, err := config.CheckConfig(configFile)
+		if err != nil {
+			_ = console.Failed(err)
+			os.Exit(1)
+		}
+		console.Info("Using config %q", configFile)
+		if len(issues) > 0 {
+			for _, issue := range issues {
+				console.Error("%s", issue)
+			}
+			_ = console.Failed(fmt.Errorf("found %d problems in config file %q",
+				len(issues), configFile))
+			os.Exit(1)
+		}
+		console.Completed("Config file %q is valid", configFile)
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(ConfigCheckCmd)
+}
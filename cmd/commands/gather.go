@@ -23,11 +23,13 @@ var GatherApplicationCmd = &cobra.Command{
 	Run: func(c *cobra.Command, args []string) {
 		if err := gather.Gather(command.ApplicationOptions{
 			Options: command.Options{
-				ConfigFile: configFile,
-				OutputDir:  outputDir,
+				ConfigFile:  configFile,
+				Environment: environment,
+				OutputDir:   outputDir,
 			},
 			DRPCName:      drpcName,
 			DRPCNamespace: drpcNamespace,
+			S3:            s3Options,
 		}); err != nil {
 			os.Exit(1)
 		}
@@ -36,6 +38,8 @@ var GatherApplicationCmd = &cobra.Command{
 
 func init() {
 	addOutputFlags(GatherCmd)
+	addEnvironmentFlags(GatherCmd)
 	addDRPCFlags(GatherApplicationCmd)
+	addS3Flags(GatherApplicationCmd)
 	GatherCmd.AddCommand(GatherApplicationCmd)
 }
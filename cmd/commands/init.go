@@ -4,20 +4,37 @@
 package commands
 
 import (
+	"errors"
 	"os"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 
 	"github.com/ramendr/ramenctl/pkg/config"
 	"github.com/ramendr/ramenctl/pkg/console"
+	"github.com/ramendr/ramenctl/pkg/discovery"
 )
 
-var envFile string
+var (
+	envFile string
+
+	// hubKubeconfig and hubContext enable discovering the configuration from the hub.
+	hubKubeconfig string
+	hubContext    string
+)
 
 var InitCmd = &cobra.Command{
 	Use:   "init",
 	Short: "Create configuration file for your clusters",
 	Run: func(c *cobra.Command, args []string) {
+		if hubKubeconfig != "" || hubContext != "" {
+			if err := discoverConfig(c); err != nil {
+				_ = console.Failed(err)
+				os.Exit(1)
+			}
+			console.Completed("Created config file %q with discovered configuration", configFile)
+			return
+		}
 		if err := config.CreateSampleConfig(
 			configFile,
 			RootCmd.DisplayName(),
@@ -30,7 +47,24 @@ var InitCmd = &cobra.Command{
 	},
 }
 
+func discoverConfig(c *cobra.Command) error {
+	if envFile != "" {
+		return errors.New("cannot use --envfile with --hub-kubeconfig or --hub-context")
+	}
+	// Ask the user only when stdin is a terminal.
+	var chooser discovery.Chooser = discovery.Auto{}
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		chooser = discovery.NewPrompt(os.Stdin, os.Stdout)
+	}
+	hub := config.Cluster{Kubeconfig: hubKubeconfig, Context: hubContext}
+	return discovery.CreateConfig(c.Context(), configFile, RootCmd.DisplayName(), hub, chooser)
+}
+
 func init() {
 	// Register the --envfile flag
 	InitCmd.Flags().StringVar(&envFile, "envfile", "", "ramen testing environment file")
+	InitCmd.Flags().StringVar(&hubKubeconfig, "hub-kubeconfig", "",
+		"discover configuration using hub kubeconfig")
+	InitCmd.Flags().StringVar(&hubContext, "hub-context", "",
+		"discover configuration using hub kubeconfig context")
 }
@@ -5,17 +5,23 @@ package commands
 
 import (
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 
 	"github.com/ramendr/ramenctl/pkg/build"
+	"github.com/ramendr/ramenctl/pkg/s3"
 )
 
 var (
 	// configFile is shared by all commands, enabling access to all clusters.
 	configFile string
 
+	// environment selects an environment in the configuration file. Used by validate and gather
+	// commands.
+	environment string
+
 	// outputDir is used by troubleshooting commands for creating a report.
 	outputDir string
 
@@ -26,6 +32,12 @@ var (
 	// applications.
 	drpcNamespace string
 
+	// s3Options limit the S3 objects gathered for protected applications.
+	s3Options s3.GatherOptions
+
+	// readinessAction is the DR action to check readiness for. Used by validate application.
+	readinessAction string
+
 	// interactive controls interactive features like opening a browser. When not set by the user,
 	// defaults to true if stdout is a terminal.
 	interactive bool
@@ -56,6 +68,12 @@ func init() {
 	RootCmd.PersistentFlags().Lookup("interactive").DefValue = "auto"
 }
 
+func addEnvironmentFlags(c *cobra.Command) {
+	c.PersistentFlags().StringVarP(&environment, "env", "e", os.Getenv("RAMENCTL_ENV"),
+		"environment in the configuration file (default $RAMENCTL_ENV)")
+	c.PersistentFlags().Lookup("env").DefValue = ""
+}
+
 func addOutputFlags(c *cobra.Command) {
 	const name = "output"
 	c.PersistentFlags().StringVarP(&outputDir, name, "o", "", "output directory")
@@ -74,3 +92,18 @@ func addDRPCFlags(c *cobra.Command) {
 	c.PersistentFlags().StringVarP(&drpcNamespace, namespace, "n", "", "drpc namespace")
 	_ = c.MarkPersistentFlagRequired(namespace)
 }
+
+func addS3Flags(c *cobra.Command) {
+	formats := []string{time.RFC3339}
+	flags := c.PersistentFlags()
+	flags.Int64Var(&s3Options.MaxTotalBytes, "s3-max-total-bytes", 0,
+		"maximum total size of S3 objects to download per profile (0 for no limit)")
+	flags.Int64Var(&s3Options.MaxObjectSize, "s3-max-object-size", 0,
+		"skip S3 objects larger than this size (0 for no limit)")
+	flags.TimeVar(&s3Options.ModifiedAfter, "s3-modified-after", time.Time{}, formats,
+		"skip S3 objects modified before this time (RFC3339)")
+	flags.TimeVar(&s3Options.ModifiedBefore, "s3-modified-before", time.Time{}, formats,
+		"skip S3 objects modified after this time (RFC3339)")
+	flags.BoolVar(&s3Options.ManifestOnly, "s3-manifest-only", false,
+		"list S3 objects in the manifest without downloading them")
+}
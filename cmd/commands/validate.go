@@ -23,6 +23,7 @@ var ValidateClustersCmd = &cobra.Command{
 	Run: func(c *cobra.Command, args []string) {
 		if err := validate.Clusters(command.Options{
 			ConfigFile:  configFile,
+			Environment: environment,
 			OutputDir:   outputDir,
 			Interactive: interactive,
 		}); err != nil {
@@ -38,11 +39,14 @@ var ValidateApplicationCmd = &cobra.Command{
 		if err := validate.Application(command.ApplicationOptions{
 			Options: command.Options{
 				ConfigFile:  configFile,
+				Environment: environment,
 				OutputDir:   outputDir,
 				Interactive: interactive,
 			},
-			DRPCName:      drpcName,
-			DRPCNamespace: drpcNamespace,
+			DRPCName:        drpcName,
+			DRPCNamespace:   drpcNamespace,
+			S3:              s3Options,
+			ReadinessAction: readinessAction,
 		}); err != nil {
 			os.Exit(1)
 		}
@@ -51,7 +55,11 @@ var ValidateApplicationCmd = &cobra.Command{
 
 func init() {
 	addDRPCFlags(ValidateApplicationCmd)
+	addS3Flags(ValidateApplicationCmd)
+	ValidateApplicationCmd.Flags().StringVar(&readinessAction, "for", "",
+		"check readiness for a DR action (failover, relocate)")
 	addOutputFlags(ValidateCmd)
+	addEnvironmentFlags(ValidateCmd)
 	ValidateCmd.AddCommand(ValidateClustersCmd)
 	ValidateCmd.AddCommand(ValidateApplicationCmd)
 }
@@ -14,6 +14,7 @@ func main() {
 	// ramenctl commands.
 	commands.RootCmd.AddCommand(
 		commands.InitCmd,
+		commands.ConfigCmd,
 		commands.TestCmd,
 		commands.GatherCmd,
 		commands.ValidateCmd,
@@ -15,7 +15,10 @@ import (
 	e2eenv "github.com/ramendr/ramen/e2e/env"
 	"github.com/ramendr/ramen/e2e/types"
 	"go.uber.org/zap"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/ramendr/ramenctl/pkg/config"
 	"github.com/ramendr/ramenctl/pkg/console"
 	"github.com/ramendr/ramenctl/pkg/report"
 )
@@ -36,6 +39,17 @@ type Command struct {
 	// env loaded from specified clusters.
 	env *types.Env
 
+	// managedClusters are the env managed clusters and the additional managed clusters.
+	managedClusters []*types.Cluster
+
+	// clusters are the clusters kubeconfigs used to create the env, keyed by the configuration
+	// cluster name.
+	clusters map[string]e2econfig.Cluster
+
+	// kubeconfigDir is a private temporary directory for clusters kubeconfigs, removed when the
+	// command is closed.
+	kubeconfigDir string
+
 	// log logging to the command log.
 	log      *zap.SugaredLogger
 	closeLog func()
@@ -49,7 +63,7 @@ type Command struct {
 // handler call Close().
 func New(
 	commandName string,
-	clusters map[string]e2econfig.Cluster,
+	clusters map[string]config.Cluster,
 	opts Options,
 ) (*Command, error) {
 	suffix, err := findNextSuffix(opts.OutputDir, commandName)
@@ -69,44 +83,94 @@ func New(
 	// the clusters block for long time. The log will contain the cancellation error.
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
 
-	env, err := e2eenv.New(ctx, clusters, log)
+	kubeconfigDir, err := os.MkdirTemp("", "ramenctl-")
 	if err != nil {
-		// Stop the signal handler before we fail.
 		stop()
+		log.Errorf("Failed to create kubeconfig directory: %s", err)
+		return nil, errors.New("failed to create kubeconfig directory")
+	}
+
+	// Stop the signal handler and remove the kubeconfigs before we fail.
+	cleanup := func() {
+		stop()
+		_ = os.RemoveAll(kubeconfigDir)
+	}
+
+	clientClusters, err := EnvClusters(clusters, kubeconfigDir)
+	if err != nil {
+		cleanup()
+		log.Errorf("Failed to resolve clusters kubeconfigs: %s", err)
+		return nil, err
+	}
+
+	env, err := e2eenv.New(ctx, clientClusters, log)
+	if err != nil {
+		cleanup()
 		log.Errorf("Failed to create env: %s", err)
 		return nil, errors.New("failed to create env")
 	}
 
+	managedClusters := env.ManagedClusters()
+	for _, name := range config.AdditionalManagedClusters(clusters) {
+		cluster, err := newManagedCluster(name, clientClusters[name])
+		if err != nil {
+			cleanup()
+			log.Errorf("Failed to create managed cluster %q: %s", name, err)
+			return nil, fmt.Errorf("failed to create managed cluster %q", name)
+		}
+		managedClusters = append(managedClusters, cluster)
+	}
+
 	return &Command{
-		name:      commandName,
-		suffix:    suffix,
-		outputDir: opts.OutputDir,
-		env:       env,
-		log:       log,
-		closeLog:  closeLog,
-		context:   ctx,
-		stop:      stop,
+		name:            commandName,
+		suffix:          suffix,
+		outputDir:       opts.OutputDir,
+		env:             env,
+		managedClusters: managedClusters,
+		clusters:        clientClusters,
+		kubeconfigDir:   kubeconfigDir,
+		log:             log,
+		closeLog:        closeLog,
+		context:         ctx,
+		stop:            stop,
 	}, nil
 }
 
+// newManagedCluster creates an additional managed cluster. The ramen e2e env detects the names of
+// the env clusters, but additional managed clusters are named by the configuration key.
+func newManagedCluster(name string, cluster e2econfig.Cluster) (*types.Cluster, error) {
+	restConfig, err := clientcmd.BuildConfigFromFlags("", cluster.Kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Cluster{Name: name, Client: c, Kubeconfig: cluster.Kubeconfig}, nil
+}
+
 // ForTest is a command configured for testing without real clusters. This command does not handle
-// signals and its context cannot be cancelled.
+// signals and its context cannot be cancelled. The additional managed clusters are added to the env
+// managed clusters.
 func ForTest(
 	commandName string,
 	env *types.Env,
 	outputDir string,
+	additional ...*types.Cluster,
 ) (*Command, error) {
 	log, closeLog, err := newLogger(outputDir, commandName+".log")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create logger: %w", err)
 	}
 	return &Command{
-		name:      commandName,
-		outputDir: outputDir,
-		env:       env,
-		log:       log,
-		closeLog:  closeLog,
-		context:   context.Background(),
+		name:            commandName,
+		outputDir:       outputDir,
+		env:             env,
+		managedClusters: append(env.ManagedClusters(), additional...),
+		log:             log,
+		closeLog:        closeLog,
+		context:         context.Background(),
 	}, nil
 }
 
@@ -139,6 +203,17 @@ func (c *Command) Env() *types.Env {
 	return c.env
 }
 
+// ManagedClusters returns the env managed clusters and the additional managed clusters.
+func (c *Command) ManagedClusters() []*types.Cluster {
+	return c.managedClusters
+}
+
+// Clusters returns the clusters kubeconfigs used to create the env. Clusters using a context use a
+// minified kubeconfig removed when the command is closed.
+func (c *Command) Clusters() map[string]e2econfig.Cluster {
+	return c.clusters
+}
+
 func (c *Command) Context() context.Context {
 	return c.context
 }
@@ -149,6 +224,9 @@ func (c *Command) Close() {
 	if c.stop != nil {
 		c.stop()
 	}
+	if c.kubeconfigDir != "" {
+		_ = os.RemoveAll(c.kubeconfigDir)
+	}
 	_ = c.log.Sync()
 	c.closeLog()
 }
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"fmt"
+	"path/filepath"
+
+	e2econfig "github.com/ramendr/ramen/e2e/config"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/ramendr/ramenctl/pkg/config"
+)
+
+// EnvClusters returns the clusters for the ramen e2e env, which uses the kubeconfig current
+// context. Clusters using a context or the default kubeconfig are written to a minified kubeconfig
+// in dir, using the cluster context as the current context. Since the kubeconfig includes the
+// cluster credentials, dir must be private and removed when the command is closed.
+func EnvClusters(
+	clusters map[string]config.Cluster,
+	dir string,
+) (map[string]e2econfig.Cluster, error) {
+	result := make(map[string]e2econfig.Cluster, len(clusters))
+	for name, cluster := range clusters {
+		if cluster.Context == "" {
+			result[name] = e2econfig.Cluster{Kubeconfig: cluster.Kubeconfig}
+			continue
+		}
+		path := filepath.Join(dir, name+".kubeconfig")
+		if err := writeContextKubeconfig(cluster, path); err != nil {
+			return nil, fmt.Errorf("failed to create %s cluster kubeconfig: %w", name, err)
+		}
+		result[name] = e2econfig.Cluster{Kubeconfig: path}
+	}
+	return result, nil
+}
+
+// writeContextKubeconfig writes a self contained kubeconfig with only the cluster context.
+func writeContextKubeconfig(cluster config.Cluster, path string) error {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if cluster.Kubeconfig != "" {
+		rules.ExplicitPath = cluster.Kubeconfig
+	}
+
+	kubeconfig, err := rules.Load()
+	if err != nil {
+		return err
+	}
+
+	kubeconfig.CurrentContext = cluster.Context
+	if err := clientcmdapi.MinifyConfig(kubeconfig); err != nil {
+		return err
+	}
+	if err := clientcmdapi.FlattenConfig(kubeconfig); err != nil {
+		return err
+	}
+
+	return clientcmd.WriteToFile(*kubeconfig, path)
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package command
+
+import (
+	"maps"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	e2econfig "github.com/ramendr/ramen/e2e/config"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/ramendr/ramenctl/pkg/config"
+)
+
+func TestEnvClusters(t *testing.T) {
+	kubeconfig := testKubeconfig(t, "hub", "dr1", "dr2")
+	t.Setenv("KUBECONFIG", kubeconfig)
+	dir := t.TempDir()
+
+	clusters := map[string]config.Cluster{
+		"hub": {Kubeconfig: "hub/config"},
+		"c1":  {Kubeconfig: kubeconfig, Context: "dr1"},
+		"c2":  {Context: "dr2"},
+	}
+	result, err := EnvClusters(clusters, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]e2econfig.Cluster{
+		"hub": {Kubeconfig: "hub/config"},
+		"c1":  {Kubeconfig: filepath.Join(dir, "c1.kubeconfig")},
+		"c2":  {Kubeconfig: filepath.Join(dir, "c2.kubeconfig")},
+	}
+	if len(result) != len(expected) {
+		t.Fatalf("expected clusters %v, got %v", expected, result)
+	}
+	for name, cluster := range expected {
+		if result[name] != cluster {
+			t.Errorf("expected cluster %q %v, got %v", name, cluster, result[name])
+		}
+	}
+
+	checkContextKubeconfig(t, result["c1"].Kubeconfig, "dr1")
+	checkContextKubeconfig(t, result["c2"].Kubeconfig, "dr2")
+}
+
+func TestEnvClustersMissingContext(t *testing.T) {
+	kubeconfig := testKubeconfig(t, "hub", "dr1")
+	clusters := map[string]config.Cluster{
+		"c2": {Kubeconfig: kubeconfig, Context: "dr2"},
+	}
+	if _, err := EnvClusters(clusters, t.TempDir()); err == nil {
+		t.Fatal("cluster with missing context did not fail")
+	}
+}
+
+func TestWriteContextKubeconfigDefault(t *testing.T) {
+	t.Setenv("KUBECONFIG", testKubeconfig(t, "hub", "dr1"))
+	path := filepath.Join(t.TempDir(), "hub.kubeconfig")
+	if err := writeContextKubeconfig(config.Cluster{Context: "hub"}, path); err != nil {
+		t.Fatal(err)
+	}
+	checkContextKubeconfig(t, path, "hub")
+}
+
+// testKubeconfig writes a kubeconfig with a cluster, user and context for every name and returns
+// its path.
+func testKubeconfig(t *testing.T, names ...string) string {
+	t.Helper()
+	kubeconfig := clientcmdapi.NewConfig()
+	for _, name := range names {
+		kubeconfig.Clusters[name+"-cluster"] = &clientcmdapi.Cluster{
+			Server: "https://" + name + ":6443",
+		}
+		kubeconfig.AuthInfos[name+"-user"] = &clientcmdapi.AuthInfo{Token: name + "-token"}
+		kubeconfig.Contexts[name] = &clientcmdapi.Context{
+			Cluster:  name + "-cluster",
+			AuthInfo: name + "-user",
+		}
+	}
+	kubeconfig.CurrentContext = names[0]
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := clientcmd.WriteToFile(*kubeconfig, path); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// checkContextKubeconfig checks that the kubeconfig at path includes only the named context, and
+// uses it as the current context.
+func checkContextKubeconfig(t *testing.T, path, name string) {
+	t.Helper()
+	kubeconfig, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kubeconfig.CurrentContext != name {
+		t.Errorf("expected current context %q, got %q", name, kubeconfig.CurrentContext)
+	}
+	contexts := slices.Sorted(maps.Keys(kubeconfig.Contexts))
+	if !slices.Equal(contexts, []string{name}) {
+		t.Errorf("expected contexts [%q], got %q", name, contexts)
+	}
+	clusters := slices.Sorted(maps.Keys(kubeconfig.Clusters))
+	if !slices.Equal(clusters, []string{name + "-cluster"}) {
+		t.Errorf("expected clusters [%q], got %q", name+"-cluster", clusters)
+	}
+	users := slices.Sorted(maps.Keys(kubeconfig.AuthInfos))
+	if !slices.Equal(users, []string{name + "-user"}) {
+		t.Errorf("expected users [%q], got %q", name+"-user", users)
+	}
+}
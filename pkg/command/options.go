@@ -3,9 +3,14 @@
 
 package command
 
+import (
+	"github.com/ramendr/ramenctl/pkg/s3"
+)
+
 // Options shared by all commands except init.
 type Options struct {
 	ConfigFile  string
+	Environment string
 	OutputDir   string
 	Interactive bool
 }
@@ -15,4 +20,11 @@ type ApplicationOptions struct {
 	Options
 	DRPCName      string
 	DRPCNamespace string
+
+	// S3 limits the S3 objects gathered for the application.
+	S3 s3.GatherOptions
+
+	// ReadinessAction is the DR action ("failover" or "relocate") to check readiness for. Used
+	// only by validate application.
+	ReadinessAction string
 }
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Issue is a problem found in a configuration file.
+type Issue struct {
+	// Line is the line in the configuration file, or 0 if the issue is not related to a line.
+	Line int
+
+	// Message describes the issue.
+	Message string
+}
+
+func (i Issue) String() string {
+	if i.Line == 0 {
+		return i.Message
+	}
+	return fmt.Sprintf("line %d: %s", i.Line, i.Message)
+}
+
+// CheckConfig checks the configuration file keys, the clusters kubeconfigs, and the test
+// references to deployers and PVC specs. Returns the issues found, or an error if the
+// configuration file cannot be read.
+func CheckConfig(filename string) ([]Issue, error) {
+	root, err := readConfigNode(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []Issue
+	configSchema.check(root, "", &issues)
+	issues = append(issues, checkClusters(root)...)
+	issues = append(issues, checkTestReferences(root)...)
+	return issues, nil
+}
+
+// checkConfigKeys returns an error describing the unknown keys in the configuration file.
+func checkConfigKeys(filename string) error {
+	root, err := readConfigNode(filename)
+	if err != nil {
+		return err
+	}
+
+	var issues []Issue
+	configSchema.check(root, "", &issues)
+	if len(issues) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		lines = append(lines, issue.String())
+	}
+	return fmt.Errorf("invalid config %q:\n   %s", filename, strings.Join(lines, "\n   "))
+}
+
+func readConfigNode(filename string) (*yaml.Node, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(data, root); err != nil {
+		return nil, fmt.Errorf("failed to parse config %q: %w", filename, err)
+	}
+	return root, nil
+}
+
+// checkClusters checks that the required clusters are configured, and that the clusters
+// kubeconfigs exist, can be parsed, and include the cluster context.
+func checkClusters(root *yaml.Node) []Issue {
+	var issues []Issue
+	kubeconfigs := map[string]*clientcmdapi.Config{}
+
+	checkSection := func(clustersKey, clusters *yaml.Node, path string) {
+		for _, name := range []string{"hub", "c1", "c2"} {
+			if !clusterIsSet(mappingValue(clusters, name)) {
+				issues = append(issues, Issue{
+					Line:    clustersKey.Line,
+					Message: fmt.Sprintf("missing %s cluster in %q", name, path),
+				})
+			}
+		}
+		for i := 0; i+1 < len(clusters.Content); i += 2 {
+			key, cluster := clusters.Content[i], clusters.Content[i+1]
+			issues = append(issues, checkCluster(key, cluster, kubeconfigs)...)
+		}
+	}
+
+	document := documentNode(root)
+	clustersKey, clusters := mappingEntry(document, "clusters")
+	if clusters != nil {
+		checkSection(clustersKey, clusters, "clusters")
+	}
+
+	_, environments := mappingEntry(document, "environments")
+	if environments != nil && environments.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(environments.Content); i += 2 {
+			envKey, env := environments.Content[i], environments.Content[i+1]
+			envClustersKey, envClusters := mappingEntry(env, "clusters")
+			switch {
+			case envClusters != nil:
+				path := fmt.Sprintf("environments.%s.clusters", envKey.Value)
+				checkSection(envClustersKey, envClusters, path)
+			case clusters == nil:
+				issues = append(issues, Issue{
+					Line:    envKey.Line,
+					Message: fmt.Sprintf("missing clusters in environment %q", envKey.Value),
+				})
+			}
+		}
+	} else if clusters == nil {
+		issues = append(issues, Issue{Message: "missing clusters"})
+	}
+
+	return issues
+}
+
+// checkCluster checks the cluster kubeconfig and context. Loaded kubeconfigs are cached in
+// kubeconfigs to avoid reporting the same kubeconfig issue for multiple clusters.
+func checkCluster(
+	key, cluster *yaml.Node,
+	kubeconfigs map[string]*clientcmdapi.Config,
+) []Issue {
+	if !clusterIsSet(cluster) {
+		// Unset optional cluster. Required clusters are checked by the caller.
+		return nil
+	}
+
+	kubeconfigNode := mappingValue(cluster, "kubeconfig")
+	contextNode := mappingValue(cluster, "context")
+
+	var path, kubeContext string
+	if kubeconfigNode != nil {
+		path = kubeconfigNode.Value
+	}
+	if contextNode != nil {
+		kubeContext = contextNode.Value
+	}
+
+	kubeconfig, seen := kubeconfigs[path]
+	if !seen {
+		var err error
+		kubeconfig, err = loadKubeconfig(path)
+		kubeconfigs[path] = kubeconfig
+		if err != nil {
+			line := key.Line
+			if kubeconfigNode != nil {
+				line = kubeconfigNode.Line
+			}
+			return []Issue{{
+				Line:    line,
+				Message: fmt.Sprintf("cluster %q: %s", key.Value, err),
+			}}
+		}
+	}
+	if kubeconfig == nil {
+		// Invalid kubeconfig, already reported.
+		return nil
+	}
+
+	if kubeContext == "" {
+		if kubeconfig.CurrentContext == "" {
+			return []Issue{{
+				Line:    key.Line,
+				Message: fmt.Sprintf("cluster %q: kubeconfig has no current context", key.Value),
+			}}
+		}
+		return nil
+	}
+
+	if _, ok := kubeconfig.Contexts[kubeContext]; !ok {
+		return []Issue{{
+			Line: contextNode.Line,
+			Message: fmt.Sprintf("cluster %q: context %q not found in kubeconfig %q",
+				key.Value, kubeContext, slices.Sorted(maps.Keys(kubeconfig.Contexts))),
+		}}
+	}
+	return nil
+}
+
+// clusterIsSet returns true if the cluster kubeconfig or context is configured.
+func clusterIsSet(cluster *yaml.Node) bool {
+	for _, key := range []string{"kubeconfig", "context"} {
+		if value := mappingValue(cluster, key); value != nil && value.Value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// loadKubeconfig loads the kubeconfig at path, or the default kubeconfig if path is empty.
+func loadKubeconfig(path string) (*clientcmdapi.Config, error) {
+	if path == "" {
+		kubeconfig, err := clientcmd.NewDefaultClientConfigLoadingRules().Load()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load default kubeconfig: %w", err)
+		}
+		return kubeconfig, nil
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("kubeconfig %q not found", path)
+		}
+		return nil, fmt.Errorf("failed to access kubeconfig %q: %w", path, err)
+	}
+	kubeconfig, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig %q: %w", path, err)
+	}
+	return kubeconfig, nil
+}
+
+// checkTestReferences checks that the tests deployers and PVC specs are defined in the
+// configuration.
+func checkTestReferences(root *yaml.Node) []Issue {
+	document := documentNode(root)
+	tests := mappingValue(document, "tests")
+	if tests == nil || tests.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	deployers := sequenceNames(mappingValue(document, "deployers"))
+	pvcSpecs := sequenceNames(mappingValue(document, "pvcSpecs"))
+
+	var issues []Issue
+	for i, test := range tests.Content {
+		if deployer := mappingValue(test, "deployer"); deployer != nil &&
+			!slices.Contains(deployers, deployer.Value) {
+			issues = append(issues, Issue{
+				Line: deployer.Line,
+				Message: fmt.Sprintf("tests[%d]: deployer %q not found in deployers %q",
+					i, deployer.Value, deployers),
+			})
+		}
+		if pvcSpec := mappingValue(test, "pvcSpec"); pvcSpec != nil &&
+			!slices.Contains(pvcSpecs, pvcSpec.Value) {
+			issues = append(issues, Issue{
+				Line: pvcSpec.Line,
+				Message: fmt.Sprintf("tests[%d]: pvcSpec %q not found in pvcSpecs %q",
+					i, pvcSpec.Value, pvcSpecs),
+			})
+		}
+	}
+	return issues
+}
+
+// sequenceNames returns the names of the items in a sequence of mappings.
+func sequenceNames(node *yaml.Node) []string {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+	var names []string
+	for _, item := range node.Content {
+		if name := mappingValue(item, "name"); name != nil {
+			names = append(names, name.Value)
+		}
+	}
+	return names
+}
+
+func documentNode(root *yaml.Node) *yaml.Node {
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		return root.Content[0]
+	}
+	return root
+}
+
+// mappingValue returns the value of key in a mapping node, or nil if not found. Keys are case
+// insensitive like viper keys.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	_, value := mappingEntry(node, key)
+	return value
+}
+
+// mappingEntry returns the key and value nodes of key in a mapping node, or nil if not found.
+func mappingEntry(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if strings.EqualFold(node.Content[i].Value, key) {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
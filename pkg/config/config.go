@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/ramendr/ramen/e2e/config"
 	"github.com/spf13/viper"
@@ -15,11 +17,38 @@ import (
 	"github.com/ramendr/ramenctl/pkg/console"
 )
 
+// DefaultOCMBackupNamespace is the default namespace of the OCM hub backup and restore resources.
+const DefaultOCMBackupNamespace = "open-cluster-management-backup"
+
+var (
+	// K8sNamespaces are the default namespaces for config.DistroK8s.
+	K8sNamespaces = Namespaces{
+		Namespaces:         config.K8sNamespaces,
+		OCMBackupNamespace: DefaultOCMBackupNamespace,
+	}
+
+	// OcpNamespaces are the default namespaces for config.DistroOcp.
+	OcpNamespaces = Namespaces{
+		Namespaces:         config.OcpNamespaces,
+		OCMBackupNamespace: DefaultOCMBackupNamespace,
+	}
+)
+
+// environmentClusters are the clusters used by the ramen e2e environment. Other clusters in the
+// configuration are additional managed clusters.
+var environmentClusters = []string{"hub", "passive-hub", "c1", "c2"}
+
 // Config is used for all ramenctl commands except the test commands. It is a subset of
 // ramen/e2e/config.Config.
 type Config struct {
-	// Clusters are part of this environment. Requires "hub", "c1", and "c2".
-	Clusters map[string]config.Cluster `json:"clusters"`
+	// Environment is the environment selected from the config file environments. Empty if the
+	// config file has no environments. Not read from the config file.
+	Environment string `json:"environment,omitempty" mapstructure:"-"`
+
+	// Clusters are part of this environment. Requires "hub", "c1", and "c2". The optional
+	// "passive-hub" is the passive hub cluster. Other clusters are additional managed clusters,
+	// keyed by the managed cluster name.
+	Clusters map[string]Cluster `json:"clusters"`
 
 	// ClusterSet name with the managed clusters.
 	ClusterSet string `json:"clusterSet"`
@@ -28,8 +57,83 @@ type Config struct {
 	// automatically when validating the config with the clusters.
 	Distro string `json:"distro"`
 
-	// Namespaces are set automatically based on Distro.
-	Namespaces config.Namespaces `json:"namespaces"`
+	// Namespaces are the effective namespaces. Namespaces specified in the config file override
+	// the defaults for Distro. Missing namespaces are set automatically based on Distro.
+	Namespaces Namespaces `json:"namespaces"`
+
+	// Operators are the ramen operators discovered on the clusters, keyed by cluster name. Set
+	// automatically when validating the config with the clusters.
+	Operators map[string]Operator `json:"operators,omitempty" mapstructure:"-"`
+
+	// S3 configures access to the S3 stores. Not included in reports since the proxy URL may
+	// include credentials. The effective transport is reported for each S3 profile.
+	S3 S3 `json:"-"`
+
+	// Validation configures the validate commands.
+	Validation Validation `json:"validation,omitzero"`
+}
+
+// Cluster is a cluster kubeconfig. Unlike ramen/e2e/config.Cluster, clusters can use a context in a
+// kubeconfig with multiple contexts.
+type Cluster struct {
+	// Kubeconfig is the path to the cluster kubeconfig. If empty and Context is set, the default
+	// kubeconfig ($KUBECONFIG or ~/.kube/config) is used.
+	Kubeconfig string `json:"kubeconfig"`
+
+	// Context is the kubeconfig context of the cluster. If empty, the kubeconfig current context
+	// is used.
+	Context string `json:"context,omitempty"`
+}
+
+// Namespaces are the ramen namespaces used by ramen e2e, and the OCM namespaces used by ramenctl.
+type Namespaces struct {
+	config.Namespaces `mapstructure:",squash"`
+
+	// OCMBackupNamespace is the namespace of the OCM hub backup and restore resources.
+	OCMBackupNamespace string `json:"ocmBackupNamespace"`
+}
+
+// IsSet returns true if the cluster kubeconfig or context is configured.
+func (c Cluster) IsSet() bool {
+	return c.Kubeconfig != "" || c.Context != ""
+}
+
+// ReadClusters reads the clusters from the configuration file, used by the test commands. Unlike
+// ramen/e2e/config.ReadConfig, the clusters include the kubeconfig context.
+func ReadClusters(filename string) (map[string]Cluster, error) {
+	v := viper.New()
+	v.SetConfigFile(filename)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read config: %v", err)
+	}
+
+	cfg := &Config{}
+	if err := v.UnmarshalKey("clusters", &cfg.Clusters); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal clusters: %v", err)
+	}
+
+	if err := cfg.validateClusters(); err != nil {
+		return nil, err
+	}
+	return cfg.Clusters, nil
+}
+
+// Operator is a ramen operator deployment discovered on a cluster.
+type Operator struct {
+	// Deployment is the name of the operator deployment.
+	Deployment string `json:"deployment"`
+
+	// Namespace is the namespace of the operator deployment and configmap.
+	Namespace string `json:"namespace"`
+
+	// ConfigMap is the name of the ramen configmap used by the operator.
+	ConfigMap string `json:"configMap"`
+
+	// Replicas is the number of replicas in the operator deployment.
+	Replicas int32 `json:"replicas"`
+
+	// DiscoveredBy describes how the operator was discovered.
+	DiscoveredBy string `json:"discoveredBy"`
 }
 
 // CreateSampleConfig create a sample config that can be used by all commands. The file can be
@@ -46,7 +150,11 @@ func CreateSampleConfig(filename, commandName, envFile string) error {
 	} else {
 		sample = NewSample(commandName)
 	}
+	return WriteSample(filename, sample)
+}
 
+// WriteSample creates a configuration file from sample. Fails if the file already exists.
+func WriteSample(filename string, sample *Sample) error {
 	content, err := sample.Bytes()
 	if err != nil {
 		return fmt.Errorf("failed to create sample config: %w", err)
@@ -62,8 +170,10 @@ func CreateSampleConfig(filename, commandName, envFile string) error {
 }
 
 // ReadConfig reads the configuration file created by CreateSampleConfig, ignoring the test only
-// configuration.
-func ReadConfig(filename string) (*Config, error) {
+// configuration. Unknown keys in the configuration file are reported as an error. If the
+// configuration file has environments, the named environment settings override the common
+// settings.
+func ReadConfig(filename, environment string) (*Config, error) {
 	viper.SetDefault("ClusterSet", config.DefaultClusterSetName)
 	viper.SetConfigFile(filename)
 
@@ -71,11 +181,20 @@ func ReadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("failed to read config: %v", err)
 	}
 
+	// Viper ignores unknown keys, so misspelled keys would silently use the defaults.
+	if err := checkConfigKeys(filename); err != nil {
+		return nil, err
+	}
+
 	cfg := &Config{}
 	if err := viper.Unmarshal(cfg); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config: %v", err)
 	}
 
+	if err := cfg.selectEnvironment(environment); err != nil {
+		return nil, err
+	}
+
 	if err := cfg.validateDistro(); err != nil {
 		return nil, err
 	}
@@ -85,14 +204,55 @@ func ReadConfig(filename string) (*Config, error) {
 	}
 
 	console.Info("Using config %q", filename)
+	if cfg.Environment != "" {
+		console.Info("Using environment %q", cfg.Environment)
+	}
 	return cfg, nil
 }
 
+// selectEnvironment applies the named environment settings over the common settings. The
+// environment clusters replace the common clusters, other settings are merged.
+func (c *Config) selectEnvironment(name string) error {
+	environments := slices.Sorted(maps.Keys(viper.GetStringMap("environments")))
+	if len(environments) == 0 {
+		if name != "" {
+			return fmt.Errorf("environment %q not found: configuration has no environments", name)
+		}
+		return nil
+	}
+	if name == "" {
+		return fmt.Errorf("environment not selected (choose one of %q)", environments)
+	}
+
+	// Viper keys are case insensitive.
+	key := strings.ToLower(name)
+	if !slices.Contains(environments, key) {
+		return fmt.Errorf("environment %q not found (choose one of %q)", name, environments)
+	}
+
+	sub := viper.Sub("environments." + key)
+	if sub == nil {
+		return fmt.Errorf("invalid environment %q", name)
+	}
+	if sub.IsSet("clusters") {
+		c.Clusters = nil
+	}
+	if err := sub.Unmarshal(c); err != nil {
+		return fmt.Errorf("failed to unmarshal environment %q: %v", name, err)
+	}
+
+	c.Environment = key
+	return nil
+}
+
 // Equal return true if config is equal to other config.
 func (c *Config) Equal(o *Config) bool {
 	if c == o {
 		return true
 	}
+	if c.Environment != o.Environment {
+		return false
+	}
 	if c.Distro != o.Distro {
 		return false
 	}
@@ -102,15 +262,24 @@ func (c *Config) Equal(o *Config) bool {
 	if c.Namespaces != o.Namespaces {
 		return false
 	}
+	if !maps.Equal(c.Operators, o.Operators) {
+		return false
+	}
+	if !c.S3.Equal(&o.S3) {
+		return false
+	}
+	if c.Validation != o.Validation {
+		return false
+	}
 	return maps.Equal(c.Clusters, o.Clusters)
 }
 
 func (c *Config) SetDistro(d string) {
 	switch d {
 	case config.DistroOcp:
-		c.Namespaces = config.OcpNamespaces
+		c.setDefaultNamespaces(OcpNamespaces)
 	case config.DistroK8s:
-		c.Namespaces = config.K8sNamespaces
+		c.setDefaultNamespaces(K8sNamespaces)
 	default:
 		panic(fmt.Sprintf("invalid distro: %q", d))
 	}
@@ -124,9 +293,9 @@ func (c *Config) validateDistro() error {
 	}
 	switch c.Distro {
 	case config.DistroK8s:
-		c.Namespaces = config.K8sNamespaces
+		c.setDefaultNamespaces(K8sNamespaces)
 	case config.DistroOcp:
-		c.Namespaces = config.OcpNamespaces
+		c.setDefaultNamespaces(OcpNamespaces)
 	default:
 		return fmt.Errorf("invalid distro %q: (choose one of %q, %q)",
 			c.Distro, config.DistroK8s, config.DistroOcp)
@@ -134,16 +303,54 @@ func (c *Config) validateDistro() error {
 	return nil
 }
 
+// setDefaultNamespaces sets the namespaces not specified in the config file to the distro
+// defaults.
+func (c *Config) setDefaultNamespaces(defaults Namespaces) {
+	if c.Namespaces.RamenHubNamespace == "" {
+		c.Namespaces.RamenHubNamespace = defaults.RamenHubNamespace
+	}
+	if c.Namespaces.RamenDRClusterNamespace == "" {
+		c.Namespaces.RamenDRClusterNamespace = defaults.RamenDRClusterNamespace
+	}
+	if c.Namespaces.RamenOpsNamespace == "" {
+		c.Namespaces.RamenOpsNamespace = defaults.RamenOpsNamespace
+	}
+	if c.Namespaces.ArgocdNamespace == "" {
+		c.Namespaces.ArgocdNamespace = defaults.ArgocdNamespace
+	}
+	if c.Namespaces.OCMBackupNamespace == "" {
+		c.Namespaces.OCMBackupNamespace = defaults.OCMBackupNamespace
+	}
+}
+
+// AdditionalManagedClusters returns the sorted names of the managed clusters in addition to "c1"
+// and "c2".
+func AdditionalManagedClusters(clusters map[string]Cluster) []string {
+	var names []string
+	for name := range clusters {
+		if !slices.Contains(environmentClusters, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
 func (c *Config) validateClusters() error {
-	if c.Clusters["hub"].Kubeconfig == "" {
+	if !c.Clusters["hub"].IsSet() {
 		return fmt.Errorf("failed to find hub cluster in configuration")
 	}
-	if c.Clusters["c1"].Kubeconfig == "" {
+	if !c.Clusters["c1"].IsSet() {
 		return fmt.Errorf("failed to find c1 cluster in configuration")
 	}
-	if c.Clusters["c2"].Kubeconfig == "" {
+	if !c.Clusters["c2"].IsSet() {
 		return fmt.Errorf("failed to find c2 cluster in configuration")
 	}
+	for _, name := range AdditionalManagedClusters(c.Clusters) {
+		if !c.Clusters[name].IsSet() {
+			return fmt.Errorf("failed to find %s cluster kubeconfig in configuration", name)
+		}
+	}
 	return nil
 }
 
@@ -4,13 +4,21 @@
 package config_test
 
 import (
+	"maps"
+	"os"
+	"path/filepath"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	e2econfig "github.com/ramendr/ramen/e2e/config"
+	"gopkg.in/yaml.v3"
 
 	"github.com/ramendr/ramenctl/pkg/config"
 	"github.com/ramendr/ramenctl/pkg/helpers"
+	"github.com/ramendr/ramenctl/pkg/s3"
 )
 
 func TestSample(t *testing.T) {
@@ -20,6 +28,8 @@ func TestSample(t *testing.T) {
 		HubKubeconfig:          "my-hub.yaml",
 		PrimaryKubeconfig:      "my-c1.yaml",
 		SecondaryKubeconfig:    "my-c2.yaml",
+		ClusterSet:             "default",
+		DRPolicy:               "dr-policy-1m",
 		RBDStorageClassName:    "rook-ceph-block",
 		CephFSStorageClassName: "rook-cephfs-fs1",
 	}
@@ -36,6 +46,8 @@ func TestSampleForODF(t *testing.T) {
 		HubKubeconfig:          "my-hub.yaml",
 		PrimaryKubeconfig:      "my-c1.yaml",
 		SecondaryKubeconfig:    "my-c2.yaml",
+		ClusterSet:             "default",
+		DRPolicy:               "dr-policy-1m",
 		RBDStorageClassName:    "ocs-storagecluster-ceph-rbd",
 		CephFSStorageClassName: "ocs-storagecluster-cephfs",
 	}
@@ -59,6 +71,8 @@ func TestSampleFromEnv(t *testing.T) {
 		HubKubeconfig:          env.KubeconfigPath("hub"),
 		PrimaryKubeconfig:      env.KubeconfigPath("dr1"),
 		SecondaryKubeconfig:    env.KubeconfigPath("dr2"),
+		ClusterSet:             "default",
+		DRPolicy:               "dr-policy-1m",
 		RBDStorageClassName:    "rook-ceph-block",
 		CephFSStorageClassName: "rook-cephfs-fs1",
 	}
@@ -70,7 +84,7 @@ func TestSampleFromEnv(t *testing.T) {
 
 func testConfig() *config.Config {
 	return &config.Config{
-		Clusters: map[string]e2econfig.Cluster{
+		Clusters: map[string]config.Cluster{
 			"hub": {Kubeconfig: "hub/config"},
 			"c1":  {Kubeconfig: "dr1/config"},
 			"c2":  {Kubeconfig: "dr2/config"},
@@ -81,7 +95,7 @@ func testConfig() *config.Config {
 
 func TestReadConfigGeneric(t *testing.T) {
 	// We read the same config from full test config or the simplified test config.
-	c, err := config.ReadConfig("testdata/generic.yaml")
+	c, err := config.ReadConfig("testdata/generic.yaml", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -94,11 +108,11 @@ func TestReadConfigGeneric(t *testing.T) {
 
 func TestReadConfigTest(t *testing.T) {
 	// We read the same config from full test config or the simplified test config.
-	c1, err := config.ReadConfig("testdata/test.yaml")
+	c1, err := config.ReadConfig("testdata/test.yaml", "")
 	if err != nil {
 		t.Fatal(err)
 	}
-	c2, err := config.ReadConfig("testdata/generic.yaml")
+	c2, err := config.ReadConfig("testdata/generic.yaml", "")
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -109,12 +123,12 @@ func TestReadConfigTest(t *testing.T) {
 }
 
 func TestReadConfigWithPassiveHub(t *testing.T) {
-	c, err := config.ReadConfig("testdata/passive-hub.yaml")
+	c, err := config.ReadConfig("testdata/passive-hub.yaml", "")
 	if err != nil {
 		t.Fatal(err)
 	}
 	expected := &config.Config{
-		Clusters: map[string]e2econfig.Cluster{
+		Clusters: map[string]config.Cluster{
 			"hub":         {Kubeconfig: "hub/config"},
 			"passive-hub": {Kubeconfig: "passive-hub/config"},
 			"c1":          {Kubeconfig: "dr1/config"},
@@ -128,6 +142,433 @@ func TestReadConfigWithPassiveHub(t *testing.T) {
 	}
 }
 
+func TestReadConfigWithManagedClusters(t *testing.T) {
+	c, err := config.ReadConfig("testdata/managed-clusters.yaml", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &config.Config{
+		Clusters: map[string]config.Cluster{
+			"hub": {Kubeconfig: "hub/config"},
+			"c1":  {Kubeconfig: "dr1/config"},
+			"c2":  {Kubeconfig: "dr2/config"},
+			"dr3": {Kubeconfig: "dr3/config"},
+			"dr4": {Kubeconfig: "dr4/config"},
+		},
+		ClusterSet: "default",
+	}
+	if !c.Equal(expected) {
+		diff := helpers.UnifiedDiff(t, expected, c)
+		t.Fatalf("configs not equal\n%s", diff)
+	}
+	names := config.AdditionalManagedClusters(c.Clusters)
+	if !slices.Equal(names, []string{"dr3", "dr4"}) {
+		t.Fatalf("unexpected additional managed clusters %q", names)
+	}
+}
+
+func TestReadConfigWithContexts(t *testing.T) {
+	c, err := config.ReadConfig("testdata/contexts.yaml", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &config.Config{
+		Clusters: map[string]config.Cluster{
+			"hub": {Kubeconfig: "envs/config", Context: "hub"},
+			"c1":  {Kubeconfig: "envs/config", Context: "dr1"},
+			// Using the default kubeconfig.
+			"c2": {Context: "dr2"},
+		},
+		ClusterSet: "default",
+	}
+	if !c.Equal(expected) {
+		diff := helpers.UnifiedDiff(t, expected, c)
+		t.Fatalf("configs not equal\n%s", diff)
+	}
+}
+
+func TestReadClusters(t *testing.T) {
+	clusters, err := config.ReadClusters("testdata/contexts.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]config.Cluster{
+		"hub": {Kubeconfig: "envs/config", Context: "hub"},
+		"c1":  {Kubeconfig: "envs/config", Context: "dr1"},
+		"c2":  {Context: "dr2"},
+	}
+	if !maps.Equal(clusters, expected) {
+		t.Fatalf("expected clusters %v, got %v", expected, clusters)
+	}
+}
+
+func TestReadClustersTestConfig(t *testing.T) {
+	clusters, err := config.ReadClusters("testdata/test.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]config.Cluster{
+		"hub": {Kubeconfig: "hub/config"},
+		"c1":  {Kubeconfig: "dr1/config"},
+		"c2":  {Kubeconfig: "dr2/config"},
+	}
+	if !maps.Equal(clusters, expected) {
+		t.Fatalf("expected clusters %v, got %v", expected, clusters)
+	}
+}
+
+func TestReadClustersSampleQuoted(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "config.yaml")
+	sample := config.NewSample("ramenctl")
+	sample.HubKubeconfig = "envs/hub: config"
+	sample.HubContext = "arn:aws:eks:us-east-1:123456789012:cluster/hub"
+	sample.PrimaryContext = "default/api-dr1:6443/admin"
+	sample.SecondaryContext = "dr2 # secondary"
+	if err := config.WriteSample(filename, sample); err != nil {
+		t.Fatal(err)
+	}
+	clusters, err := config.ReadClusters(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]config.Cluster{
+		"hub":         {Kubeconfig: sample.HubKubeconfig, Context: sample.HubContext},
+		"passive-hub": {},
+		"c1":          {Kubeconfig: sample.PrimaryKubeconfig, Context: sample.PrimaryContext},
+		"c2":          {Kubeconfig: sample.SecondaryKubeconfig, Context: sample.SecondaryContext},
+	}
+	if !maps.Equal(clusters, expected) {
+		t.Fatalf("expected clusters %v, got %v", expected, clusters)
+	}
+}
+
+func TestReadConfigWithEnvironment(t *testing.T) {
+	c, err := config.ReadConfig("testdata/environments.yaml", "staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &config.Config{
+		Environment: "staging",
+		Clusters: map[string]config.Cluster{
+			"hub": {Kubeconfig: "staging/hub/config"},
+			"c1":  {Kubeconfig: "staging/dr1/config"},
+			"c2":  {Kubeconfig: "staging/dr2/config"},
+		},
+		ClusterSet: "default",
+		Distro:     e2econfig.DistroK8s,
+		Namespaces: config.K8sNamespaces,
+		Validation: config.Validation{ClockSkewThreshold: 30 * time.Second},
+	}
+	if !c.Equal(expected) {
+		diff := helpers.UnifiedDiff(t, expected, c)
+		t.Fatalf("configs not equal\n%s", diff)
+	}
+}
+
+func TestReadConfigWithEnvironmentOverrides(t *testing.T) {
+	c, err := config.ReadConfig("testdata/environments.yaml", "Production")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &config.Config{
+		Environment: "production",
+		Clusters: map[string]config.Cluster{
+			"hub": {Kubeconfig: "production/hub/config"},
+			"c1":  {Kubeconfig: "production/dr1/config"},
+			"c2":  {Kubeconfig: "production/dr2/config"},
+		},
+		ClusterSet: "dr-clusters",
+		Distro:     e2econfig.DistroOcp,
+		Namespaces: config.OcpNamespaces,
+		Validation: config.Validation{
+			StuckProgressionTimeout: time.Hour,
+			ClockSkewThreshold:      30 * time.Second,
+		},
+	}
+	expected.Namespaces.RamenHubNamespace = "my-ramen-hub"
+	if !c.Equal(expected) {
+		diff := helpers.UnifiedDiff(t, expected, c)
+		t.Fatalf("configs not equal\n%s", diff)
+	}
+}
+
+func TestReadConfigEnvironmentErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		filename    string
+		environment string
+	}{
+		{name: "not selected", filename: "testdata/environments.yaml"},
+		{name: "not found", filename: "testdata/environments.yaml", environment: "testing"},
+		{name: "no environments", filename: "testdata/generic.yaml", environment: "staging"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := config.ReadConfig(tt.filename, tt.environment); err == nil {
+				t.Fatal("reading config did not fail")
+			}
+		})
+	}
+}
+
+func TestReadConfigUnknownKeys(t *testing.T) {
+	if _, err := config.ReadConfig("testdata/check-invalid.yaml", ""); err == nil {
+		t.Fatal("reading config with unknown keys did not fail")
+	}
+}
+
+func TestReadSampleConfig(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "config.yaml")
+	if err := config.CreateSampleConfig(filename, "ramenctl", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := config.ReadConfig(filename, ""); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckConfigValid(t *testing.T) {
+	issues, err := config.CheckConfig("testdata/check-valid.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 0 {
+		t.Fatalf("unexpected issues %q", issues)
+	}
+}
+
+func TestCheckConfigInvalid(t *testing.T) {
+	issues, err := config.CheckConfig("testdata/check-invalid.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []config.Issue{
+		{Line: 14, Message: `unknown key "clustrSet" (did you mean "clusterSet"?)`},
+		{Line: 16, Message: `unknown key "validation.clockSkew"`},
+		{Line: 10, Message: `cluster "c1": kubeconfig "testdata/missing.yaml" not found`},
+		{
+			Line:    13,
+			Message: `cluster "c2": context "dr3" not found in kubeconfig ["dr1" "dr2" "hub"]`,
+		},
+		{Line: 25, Message: `tests[0]: deployer "subscr" not found in deployers ["appset"]`},
+		{Line: 26, Message: `tests[0]: pvcSpec "cephfs" not found in pvcSpecs ["rbd"]`},
+	}
+	if !slices.Equal(issues, expected) {
+		diff := helpers.UnifiedDiff(t, expected, issues)
+		t.Fatalf("issues not equal\n%s", diff)
+	}
+}
+
+func TestCheckConfigAllFields(t *testing.T) {
+	// Every key decoded into the configuration structs must be accepted, so adding a field without
+	// updating the configuration schema fails.
+	document := map[string]any{}
+	maps.Copy(document, testConfigValue(reflect.TypeFor[config.Config]()).(map[string]any))
+	maps.Copy(document, testConfigValue(reflect.TypeFor[e2econfig.Config]()).(map[string]any))
+	document["environments"] = map[string]any{
+		"env1": testConfigValue(reflect.TypeFor[config.Config]()),
+	}
+	data, err := yaml.Marshal(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filename := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(filename, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	issues, err := config.CheckConfig(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, issue := range issues {
+		if strings.HasPrefix(issue.Message, "unknown key") {
+			t.Errorf("%s\n%s", issue, data)
+		}
+	}
+}
+
+func TestCheckConfigNotDecodedKeys(t *testing.T) {
+	// Config fields set automatically are not configuration keys.
+	filename := filepath.Join(t.TempDir(), "config.yaml")
+	data := []byte("environment: env1\noperators: {}\n")
+	if err := os.WriteFile(filename, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	issues, err := config.CheckConfig(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []config.Issue{
+		{Line: 1, Message: `unknown key "environment" (did you mean "environments"?)`},
+		{Line: 2, Message: `unknown key "operators"`},
+	}
+	if !slices.Equal(issues[:min(len(issues), 2)], expected) {
+		diff := helpers.UnifiedDiff(t, expected, issues)
+		t.Fatalf("issues not equal\n%s", diff)
+	}
+}
+
+// testConfigValue returns a value of type t decoded by viper, with all struct fields set. Struct
+// fields are keyed by the json name or the field name, since viper keys are case insensitive.
+func testConfigValue(t reflect.Type) any {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return testConfigValue(t.Elem())
+	case reflect.Map:
+		return map[string]any{"key": testConfigValue(t.Elem())}
+	case reflect.Slice:
+		return []any{testConfigValue(t.Elem())}
+	case reflect.Struct:
+		fields := map[string]any{}
+		for i := range t.NumField() {
+			field := t.Field(i)
+			if !field.IsExported() || field.Tag.Get("mapstructure") == "-" {
+				continue
+			}
+			value := testConfigValue(field.Type)
+			if field.Tag.Get("mapstructure") == ",squash" {
+				maps.Copy(fields, value.(map[string]any))
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "" || name == "-" {
+				name = field.Name
+			}
+			fields[name] = value
+		}
+		return fields
+	default:
+		return "value"
+	}
+}
+
+func TestReadConfigWithS3(t *testing.T) {
+	c, err := config.ReadConfig("testdata/s3.yaml", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	insecure := true
+	expected := testConfig()
+	expected.S3 = config.S3{
+		S3Transport: config.S3Transport{
+			ProxyURL: "http://proxy:3128",
+			CABundle: "ca-bundle.pem",
+		},
+		Profiles: map[string]config.S3Transport{
+			"minio-on-dr1": {InsecureSkipTLSVerify: &insecure},
+			"minio-on-dr2": {ProxyURL: "http://proxy-dr2:3128"},
+		},
+	}
+	if !c.Equal(expected) {
+		diff := helpers.UnifiedDiff(t, expected, c)
+		t.Fatalf("configs not equal\n%s", diff)
+	}
+}
+
+func TestReadConfigWithValidation(t *testing.T) {
+	c, err := config.ReadConfig("testdata/validation.yaml", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := testConfig()
+	expected.Validation = config.Validation{
+		StuckProgressionTimeout: time.Hour,
+		ClockSkewThreshold:      30 * time.Second,
+		BackupAgeThreshold:      12 * time.Hour,
+	}
+	if !c.Equal(expected) {
+		diff := helpers.UnifiedDiff(t, expected, c)
+		t.Fatalf("configs not equal\n%s", diff)
+	}
+}
+
+func TestValidationDefaults(t *testing.T) {
+	v := config.Validation{}
+	if v.StuckProgression() != config.DefaultStuckProgressionTimeout {
+		t.Errorf("expected stuck progression %v, got %v",
+			config.DefaultStuckProgressionTimeout, v.StuckProgression())
+	}
+	if v.ClockSkew() != config.DefaultClockSkewThreshold {
+		t.Errorf("expected clock skew %v, got %v",
+			config.DefaultClockSkewThreshold, v.ClockSkew())
+	}
+	if v.BackupAge() != config.DefaultBackupAgeThreshold {
+		t.Errorf("expected backup age %v, got %v",
+			config.DefaultBackupAgeThreshold, v.BackupAge())
+	}
+}
+
+func TestReadConfigWithNamespaces(t *testing.T) {
+	c, err := config.ReadConfig("testdata/namespaces.yaml", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := testConfig()
+	expected.Distro = e2econfig.DistroK8s
+	expected.Namespaces = config.K8sNamespaces
+	expected.Namespaces.RamenHubNamespace = "my-ramen-hub"
+	expected.Namespaces.ArgocdNamespace = "my-argocd"
+	expected.Namespaces.OCMBackupNamespace = "my-backup"
+	if !c.Equal(expected) {
+		diff := helpers.UnifiedDiff(t, expected, c)
+		t.Fatalf("configs not equal\n%s", diff)
+	}
+}
+
+func TestSetDistroKeepsNamespaces(t *testing.T) {
+	c := testConfig()
+	c.Namespaces.RamenDRClusterNamespace = "my-ramen-dr-cluster"
+	c.SetDistro(e2econfig.DistroOcp)
+	expected := config.OcpNamespaces
+	expected.RamenDRClusterNamespace = "my-ramen-dr-cluster"
+	if c.Namespaces != expected {
+		t.Fatalf("expected namespaces %+v, got %+v", expected, c.Namespaces)
+	}
+}
+
+func TestS3Transport(t *testing.T) {
+	c, err := config.ReadConfig("testdata/s3.yaml", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		profile  string
+		expected s3.Transport
+	}{
+		{
+			profile: "minio-on-dr1",
+			expected: s3.Transport{
+				ProxyURL:              "http://proxy:3128",
+				CABundleFile:          "ca-bundle.pem",
+				InsecureSkipTLSVerify: true,
+			},
+		},
+		{
+			profile: "minio-on-dr2",
+			expected: s3.Transport{
+				ProxyURL:     "http://proxy-dr2:3128",
+				CABundleFile: "ca-bundle.pem",
+			},
+		},
+		{
+			profile: "other",
+			expected: s3.Transport{
+				ProxyURL:     "http://proxy:3128",
+				CABundleFile: "ca-bundle.pem",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.profile, func(t *testing.T) {
+			transport := c.S3.Transport(tt.profile)
+			if transport != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, transport)
+			}
+		})
+	}
+}
+
 func TestConfigEqual(t *testing.T) {
 	c1 := testConfig()
 	t.Run("equal to itself", func(t *testing.T) {
@@ -148,6 +589,13 @@ func TestConfigEqual(t *testing.T) {
 
 func TestConfigNotEqual(t *testing.T) {
 	c1 := testConfig()
+	t.Run("environment", func(t *testing.T) {
+		c2 := testConfig()
+		c2.Environment = "modified"
+		if c1.Equal(c2) {
+			t.Fatalf("config with modified environment is equal\n%s", helpers.MarshalYAML(t, c2))
+		}
+	})
 	t.Run("distro", func(t *testing.T) {
 		c2 := testConfig()
 		c2.Distro = "modified"
@@ -164,9 +612,37 @@ func TestConfigNotEqual(t *testing.T) {
 	})
 	t.Run("clusters", func(t *testing.T) {
 		c2 := testConfig()
-		c2.Clusters["c2"] = e2econfig.Cluster{Kubeconfig: "modified"}
+		c2.Clusters["c2"] = config.Cluster{Kubeconfig: "modified"}
 		if c1.Equal(c2) {
 			t.Fatalf("config with modified clusters is equal\n%s", helpers.MarshalYAML(t, c2))
 		}
 	})
+	t.Run("cluster context", func(t *testing.T) {
+		c2 := testConfig()
+		c2.Clusters["c2"] = config.Cluster{Kubeconfig: "dr2/config", Context: "modified"}
+		if c1.Equal(c2) {
+			t.Fatalf("config with modified context is equal\n%s", helpers.MarshalYAML(t, c2))
+		}
+	})
+	t.Run("operators", func(t *testing.T) {
+		c2 := testConfig()
+		c2.Operators = map[string]config.Operator{"hub": {Deployment: "modified"}}
+		if c1.Equal(c2) {
+			t.Fatalf("config with modified operators is equal\n%s", helpers.MarshalYAML(t, c2))
+		}
+	})
+	t.Run("validation", func(t *testing.T) {
+		c2 := testConfig()
+		c2.Validation.StuckProgressionTimeout = time.Hour
+		if c1.Equal(c2) {
+			t.Fatalf("config with modified validation is equal\n%s", helpers.MarshalYAML(t, c2))
+		}
+	})
+	t.Run("s3", func(t *testing.T) {
+		c2 := testConfig()
+		c2.S3.Profiles = map[string]config.S3Transport{"modified": {ProxyURL: "modified"}}
+		if c1.Equal(c2) {
+			t.Fatalf("config with modified s3 is equal")
+		}
+	})
 }
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"maps"
+	"time"
+
+	"github.com/ramendr/ramenctl/pkg/s3"
+)
+
+// S3Transport configures the HTTP transport used to access S3 stores. Unset values use the
+// defaults.
+type S3Transport struct {
+	// ProxyURL is the HTTP proxy for accessing S3 stores. If unset, the proxy is configured from
+	// the environment (HTTPS_PROXY, HTTP_PROXY, NO_PROXY).
+	ProxyURL string `json:"proxyURL,omitempty"`
+
+	// CABundle is a path to a PEM file with extra CA certificates, trusted in addition to the
+	// profile CA certificate.
+	CABundle string `json:"caBundle,omitempty"`
+
+	// InsecureSkipTLSVerify disables verification of the S3 endpoint certificate.
+	InsecureSkipTLSVerify *bool `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// DefaultCertificateExpiryDays is the default number of days before a CA certificate expires when
+// validation warns about the certificate.
+const DefaultCertificateExpiryDays = 30
+
+// S3 configures access to the S3 stores used by ramen.
+type S3 struct {
+	// S3Transport applies to all S3 profiles.
+	S3Transport `mapstructure:",squash"`
+
+	// Profiles override the transport for specific S3 profiles.
+	Profiles map[string]S3Transport `json:"profiles,omitempty"`
+
+	// CertificateExpiryDays warns about CA certificates expiring within this number of days. If
+	// unset, DefaultCertificateExpiryDays is used.
+	CertificateExpiryDays int `json:"certificateExpiryDays,omitempty"`
+}
+
+// CertificateExpiry returns the duration before a CA certificate expires when validation warns
+// about the certificate.
+func (s *S3) CertificateExpiry() time.Duration {
+	days := s.CertificateExpiryDays
+	if days <= 0 {
+		days = DefaultCertificateExpiryDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// Transport returns the effective transport for the named S3 profile. Profile values override the
+// global values.
+func (s *S3) Transport(profileName string) s3.Transport {
+	t := s3.Transport{
+		ProxyURL:     s.ProxyURL,
+		CABundleFile: s.CABundle,
+	}
+	if s.InsecureSkipTLSVerify != nil {
+		t.InsecureSkipTLSVerify = *s.InsecureSkipTLSVerify
+	}
+	if p, ok := s.Profiles[profileName]; ok {
+		if p.ProxyURL != "" {
+			t.ProxyURL = p.ProxyURL
+		}
+		if p.CABundle != "" {
+			t.CABundleFile = p.CABundle
+		}
+		if p.InsecureSkipTLSVerify != nil {
+			t.InsecureSkipTLSVerify = *p.InsecureSkipTLSVerify
+		}
+	}
+	return t
+}
+
+// Equal return true if s3 config is equal to other s3 config.
+func (s *S3) Equal(o *S3) bool {
+	if s == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if !s.S3Transport.Equal(&o.S3Transport) {
+		return false
+	}
+	if s.CertificateExpiryDays != o.CertificateExpiryDays {
+		return false
+	}
+	return maps.EqualFunc(s.Profiles, o.Profiles, func(a, b S3Transport) bool {
+		return a.Equal(&b)
+	})
+}
+
+// Equal return true if transport is equal to other transport.
+func (t *S3Transport) Equal(o *S3Transport) bool {
+	if t == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if t.ProxyURL != o.ProxyURL {
+		return false
+	}
+	if t.CABundle != o.CABundle {
+		return false
+	}
+	if (t.InsecureSkipTLSVerify == nil) != (o.InsecureSkipTLSVerify == nil) {
+		return false
+	}
+	if t.InsecureSkipTLSVerify != nil && *t.InsecureSkipTLSVerify != *o.InsecureSkipTLSVerify {
+		return false
+	}
+	return true
+}
@@ -15,22 +15,34 @@ var sampleConfig string
 type Sample struct {
 	CommandName            string
 	HubKubeconfig          string
+	HubContext             string
 	PrimaryKubeconfig      string
+	PrimaryContext         string
 	SecondaryKubeconfig    string
+	SecondaryContext       string
+	ClusterSet             string
+	DRPolicy               string
 	RBDStorageClassName    string
 	CephFSStorageClassName string
 }
 
+const (
+	sampleClusterSet = "default"
+	sampleDRPolicy   = "dr-policy-1m"
+)
+
 func NewSample(commandName string) *Sample {
 	sample := &Sample{
 		CommandName:         commandName,
 		HubKubeconfig:       "my-hub.yaml",
 		PrimaryKubeconfig:   "my-c1.yaml",
 		SecondaryKubeconfig: "my-c2.yaml",
+		ClusterSet:          sampleClusterSet,
+		DRPolicy:            sampleDRPolicy,
 	}
 
-	// When running as `odf dr init` we optimize for ODF cluster.
-	// TODO: look up available storage classes in the cluster and let the user choose?
+	// When running as `odf dr init` we optimize for ODF cluster. To use the storage classes in the
+	// clusters, discover the configuration from the hub.
 	if commandName == "odf dr" {
 		sample.RBDStorageClassName = "ocs-storagecluster-ceph-rbd"
 		sample.CephFSStorageClassName = "ocs-storagecluster-cephfs"
@@ -49,6 +61,8 @@ func SampleFromEnv(commandName string, env *EnvFile) *Sample {
 		HubKubeconfig:       env.KubeconfigPath(env.Ramen.Hub),
 		PrimaryKubeconfig:   env.KubeconfigPath(env.Ramen.Clusters[0]),
 		SecondaryKubeconfig: env.KubeconfigPath(env.Ramen.Clusters[1]),
+		ClusterSet:          sampleClusterSet,
+		DRPolicy:            sampleDRPolicy,
 
 		// TODO: Get the info from the envfile instead of hard-coding.
 		RBDStorageClassName:    "rook-ceph-block",
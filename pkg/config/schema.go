@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	e2econfig "github.com/ramendr/ramen/e2e/config"
+	"gopkg.in/yaml.v3"
+)
+
+// maxSuggestionDistance is the maximum edit distance between an unknown key and a suggested key.
+const maxSuggestionDistance = 2
+
+// schema describes the allowed keys in a configuration file node. A nil schema accepts any value.
+// Keys are case insensitive like viper keys.
+type schema struct {
+	// fields are the allowed keys in a mapping.
+	fields map[string]*schema
+
+	// values is the schema of the values in a mapping with arbitrary keys.
+	values *schema
+
+	// items is the schema of the items in a sequence.
+	items *schema
+}
+
+// configSchema describes the configuration file used by all commands, including the test commands
+// options. It is derived from the keys decoded into Config and the ramen e2e config.Config.
+// Environments may override the Config keys.
+var configSchema = newConfigSchema()
+
+func newConfigSchema() *schema {
+	s := mergeSchemas(
+		schemaOf(reflect.TypeFor[Config]()),
+		schemaOf(reflect.TypeFor[e2econfig.Config]()),
+	)
+	s.fields["environments"] = &schema{values: schemaOf(reflect.TypeFor[Config]())}
+	return s
+}
+
+// schemaOf returns the schema of the keys decoded into a value of type t. Returns nil for types
+// accepting any value, such as strings, numbers, and maps or slices of such values.
+func schemaOf(t reflect.Type) *schema {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return schemaOf(t.Elem())
+	case reflect.Map:
+		if values := schemaOf(t.Elem()); values != nil {
+			return &schema{values: values}
+		}
+		return nil
+	case reflect.Slice:
+		if items := schemaOf(t.Elem()); items != nil {
+			return &schema{items: items}
+		}
+		return nil
+	case reflect.Struct:
+		if t == reflect.TypeFor[time.Time]() {
+			return nil
+		}
+		s := &schema{fields: map[string]*schema{}}
+		addStructFields(s, t)
+		return s
+	default:
+		return nil
+	}
+}
+
+// addStructFields adds the fields of struct type t to s. Like viper, fields tagged with
+// `mapstructure:"-"` are not decoded, and fields tagged with `mapstructure:",squash"` are decoded
+// from the parent mapping.
+func addStructFields(s *schema, t reflect.Type) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, options, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if name == "-" {
+			continue
+		}
+		if options == "squash" {
+			addStructFields(s, field.Type)
+			continue
+		}
+		s.fields[fieldKey(field)] = schemaOf(field.Type)
+	}
+}
+
+// fieldKey returns the configuration key of a struct field. Viper matches keys case
+// insensitively, so the key is used only in messages. We use the json name if set, otherwise the
+// field name in lower camel case.
+func fieldKey(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+	return lowerCamelCase(field.Name)
+}
+
+// lowerCamelCase converts a Go field name to lower camel case, keeping initialisms lower case
+// ("PVCSpecs" -> "pvcSpecs", "DRPolicy" -> "drPolicy", "URL" -> "url").
+func lowerCamelCase(name string) string {
+	upper := 0
+	for upper < len(name) && unicode.IsUpper(rune(name[upper])) {
+		upper++
+	}
+	switch {
+	case upper == len(name):
+		return strings.ToLower(name)
+	case upper > 1:
+		// The last upper case letter starts the next word.
+		upper--
+	}
+	return strings.ToLower(name[:upper]) + name[upper:]
+}
+
+// mergeSchemas returns a schema accepting the keys of both a and b. If only one of the schemas is
+// set, it is returned as is.
+func mergeSchemas(a, b *schema) *schema {
+	if a == nil || b == nil {
+		if a != nil {
+			return a
+		}
+		return b
+	}
+	merged := &schema{
+		fields: maps.Clone(a.fields),
+		values: mergeSchemas(a.values, b.values),
+		items:  mergeSchemas(a.items, b.items),
+	}
+	for name, field := range b.fields {
+		if existing, ok := merged.field(name); ok {
+			merged.fields[existing] = mergeSchemas(merged.fields[existing], field)
+		} else {
+			if merged.fields == nil {
+				merged.fields = map[string]*schema{}
+			}
+			merged.fields[name] = field
+		}
+	}
+	return merged
+}
+
+// check appends an issue for every unknown key in node.
+func (s *schema) check(node *yaml.Node, path string, issues *[]Issue) {
+	if s == nil {
+		return
+	}
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			s.check(child, path, issues)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			keyPath := joinPath(path, key.Value)
+			if s.values != nil {
+				s.values.check(value, keyPath, issues)
+				continue
+			}
+			name, ok := s.field(key.Value)
+			if !ok {
+				*issues = append(*issues, Issue{
+					Line:    key.Line,
+					Message: unknownKeyMessage(keyPath, s.suggest(key.Value)),
+				})
+				continue
+			}
+			s.fields[name].check(value, joinPath(path, name), issues)
+		}
+	case yaml.SequenceNode:
+		if s.items == nil {
+			return
+		}
+		for i, item := range node.Content {
+			s.items.check(item, fmt.Sprintf("%s[%d]", path, i), issues)
+		}
+	}
+}
+
+// field returns the schema field name matching key.
+func (s *schema) field(key string) (string, bool) {
+	for name := range s.fields {
+		if strings.EqualFold(name, key) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// suggest returns the closest field name to key, or an empty string if no field is close enough.
+func (s *schema) suggest(key string) string {
+	var suggestion string
+	best := maxSuggestionDistance + 1
+	for name := range s.fields {
+		d := editDistance(strings.ToLower(name), strings.ToLower(key))
+		if d < best || d == best && name < suggestion {
+			suggestion, best = name, d
+		}
+	}
+	return suggestion
+}
+
+func unknownKeyMessage(path, suggestion string) string {
+	if suggestion == "" {
+		return fmt.Sprintf("unknown key %q", path)
+	}
+	return fmt.Sprintf("unknown key %q (did you mean %q?)", path, suggestion)
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+const (
+	// DefaultStuckProgressionTimeout is the default duration a DRPC may be in a non-stable
+	// progression before validation reports it as stuck.
+	DefaultStuckProgressionTimeout = 30 * time.Minute
+
+	// DefaultClockSkewThreshold is the default clock skew between the clusters and the local host
+	// before validation reports a warning.
+	DefaultClockSkewThreshold = time.Minute
+
+	// DefaultBackupAgeThreshold is the default age of the last hub backup before validation reports
+	// the backup as stale.
+	DefaultBackupAgeThreshold = 24 * time.Hour
+)
+
+// Validation configures the validate commands.
+type Validation struct {
+	// StuckProgressionTimeout reports a DRPC in a non-stable progression for longer than this
+	// duration as stuck. If unset, DefaultStuckProgressionTimeout is used.
+	StuckProgressionTimeout time.Duration `json:"stuckProgressionTimeout,omitempty"`
+
+	// ClockSkewThreshold reports a cluster clock skew larger than this duration. If unset,
+	// DefaultClockSkewThreshold is used.
+	ClockSkewThreshold time.Duration `json:"clockSkewThreshold,omitempty"`
+
+	// BackupAgeThreshold reports a last hub backup older than this duration as stale. If unset,
+	// DefaultBackupAgeThreshold is used.
+	BackupAgeThreshold time.Duration `json:"backupAgeThreshold,omitempty"`
+}
+
+// StuckProgression returns the duration a DRPC may be in a non-stable progression before
+// validation reports it as stuck.
+func (v *Validation) StuckProgression() time.Duration {
+	if v.StuckProgressionTimeout <= 0 {
+		return DefaultStuckProgressionTimeout
+	}
+	return v.StuckProgressionTimeout
+}
+
+// ClockSkew returns the maximum clock skew between the clusters and the local host before
+// validation reports a warning.
+func (v *Validation) ClockSkew() time.Duration {
+	if v.ClockSkewThreshold <= 0 {
+		return DefaultClockSkewThreshold
+	}
+	return v.ClockSkewThreshold
+}
+
+// BackupAge returns the maximum age of the last hub backup before validation reports the backup as
+// stale.
+func (v *Validation) BackupAge() time.Duration {
+	if v.BackupAgeThreshold <= 0 {
+		return DefaultBackupAgeThreshold
+	}
+	return v.BackupAgeThreshold
+}
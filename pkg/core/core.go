@@ -16,6 +16,10 @@ const (
 	secretPlural    = "secrets"
 )
 
+func ListPVCs(reader gathering.OutputReader, namespace string) ([]string, error) {
+	return reader.ListResources(namespace, pvcPlural)
+}
+
 func ReadPVC(
 	reader gathering.OutputReader,
 	name, namespace string,
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package discovery discovers the ramenctl configuration from the hub cluster.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	ocmv1 "open-cluster-management.io/api/cluster/v1"
+	ocmv1b2 "open-cluster-management.io/api/cluster/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ramendr/ramenctl/pkg/config"
+)
+
+// Result is the configuration discovered from the hub.
+type Result struct {
+	// Hub is the hub cluster kubeconfig and context used for discovery.
+	Hub config.Cluster
+
+	// ClusterSets are the managed cluster sets on the hub, sorted by name.
+	ClusterSets []ClusterSet
+
+	// DRPolicies are the DRPolicies on the hub, sorted by name.
+	DRPolicies []DRPolicy
+
+	// Contexts are the contexts in the hub kubeconfig, sorted by name.
+	Contexts []string
+
+	// ClusterContexts are the hub kubeconfig contexts matching a managed cluster, keyed by the
+	// managed cluster name. A context matches if it has the managed cluster name, or if its
+	// cluster server is the managed cluster API server.
+	ClusterContexts map[string][]string
+}
+
+// ClusterSet is a managed cluster set and its managed clusters.
+type ClusterSet struct {
+	Name     string
+	Clusters []string
+}
+
+// DRPolicy is a DRPolicy, its clusters, and the storage classes in the policy peer classes.
+type DRPolicy struct {
+	Name           string
+	Clusters       []string
+	StorageClasses []string
+}
+
+// Discover reads the ManagedClusterSets, ManagedClusters and DRPolicies from the hub, and matches
+// the managed clusters with the hub kubeconfig contexts. If kubeconfig is empty the default
+// kubeconfig is used, and if kubeContext is empty the kubeconfig current context is used.
+func Discover(ctx context.Context, kubeconfig, kubeContext string) (*Result, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if kubeconfig != "" {
+		rules.ExplicitPath = kubeconfig
+	}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: kubeContext}
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
+
+	rawConfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	if kubeContext == "" {
+		kubeContext = rawConfig.CurrentContext
+	}
+
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create hub client config: %w", err)
+	}
+	hub, err := newClient(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create hub client: %w", err)
+	}
+
+	result := &Result{
+		Hub:             config.Cluster{Kubeconfig: kubeconfig, Context: kubeContext},
+		Contexts:        slices.Sorted(maps.Keys(rawConfig.Contexts)),
+		ClusterContexts: map[string][]string{},
+	}
+
+	clusters := &ocmv1.ManagedClusterList{}
+	if err := hub.List(ctx, clusters); err != nil {
+		return nil, fmt.Errorf("failed to list ManagedClusters: %w", err)
+	}
+
+	clusterSets := &ocmv1b2.ManagedClusterSetList{}
+	if err := hub.List(ctx, clusterSets); err != nil {
+		return nil, fmt.Errorf("failed to list ManagedClusterSets: %w", err)
+	}
+	result.ClusterSets = clusterSetsFromList(clusterSets, clusters)
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		result.ClusterContexts[cluster.Name] = matchingContexts(&rawConfig, cluster)
+	}
+
+	drPolicies := &ramenapi.DRPolicyList{}
+	if err := hub.List(ctx, drPolicies); err != nil {
+		return nil, fmt.Errorf("failed to list DRPolicies: %w", err)
+	}
+	result.DRPolicies = drPoliciesFromList(drPolicies)
+
+	return result, nil
+}
+
+// ClusterSetsWith returns the cluster sets containing all clusters.
+func (r *Result) ClusterSetsWith(clusters []string) []ClusterSet {
+	var result []ClusterSet
+	for _, clusterSet := range r.ClusterSets {
+		if !slices.ContainsFunc(clusters, func(name string) bool {
+			return !slices.Contains(clusterSet.Clusters, name)
+		}) {
+			result = append(result, clusterSet)
+		}
+	}
+	return result
+}
+
+func newClient(restConfig *rest.Config) (client.Client, error) {
+	scheme := runtime.NewScheme()
+	if err := ocmv1.Install(scheme); err != nil {
+		return nil, err
+	}
+	if err := ocmv1b2.Install(scheme); err != nil {
+		return nil, err
+	}
+	if err := ramenapi.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return client.New(restConfig, client.Options{Scheme: scheme})
+}
+
+func clusterSetsFromList(
+	clusterSets *ocmv1b2.ManagedClusterSetList,
+	clusters *ocmv1.ManagedClusterList,
+) []ClusterSet {
+	var result []ClusterSet
+	for i := range clusterSets.Items {
+		clusterSet := ClusterSet{Name: clusterSets.Items[i].Name}
+		for j := range clusters.Items {
+			cluster := &clusters.Items[j]
+			if cluster.Labels[ocmv1b2.ClusterSetLabel] == clusterSet.Name {
+				clusterSet.Clusters = append(clusterSet.Clusters, cluster.Name)
+			}
+		}
+		slices.Sort(clusterSet.Clusters)
+		result = append(result, clusterSet)
+	}
+	slices.SortFunc(result, func(a, b ClusterSet) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result
+}
+
+func drPoliciesFromList(drPolicies *ramenapi.DRPolicyList) []DRPolicy {
+	var result []DRPolicy
+	for i := range drPolicies.Items {
+		drPolicy := &drPolicies.Items[i]
+		var storageClasses []string
+		for _, peerClass := range drPolicy.Status.Async.PeerClasses {
+			storageClasses = append(storageClasses, peerClass.StorageClassName)
+		}
+		for _, peerClass := range drPolicy.Status.Sync.PeerClasses {
+			storageClasses = append(storageClasses, peerClass.StorageClassName)
+		}
+		slices.Sort(storageClasses)
+		result = append(result, DRPolicy{
+			Name:           drPolicy.Name,
+			Clusters:       slices.Clone(drPolicy.Spec.DRClusters),
+			StorageClasses: slices.Compact(storageClasses),
+		})
+	}
+	slices.SortFunc(result, func(a, b DRPolicy) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result
+}
+
+// matchingContexts returns the sorted kubeconfig contexts matching the managed cluster.
+func matchingContexts(kubeconfig *clientcmdapi.Config, cluster *ocmv1.ManagedCluster) []string {
+	var servers []string
+	for _, clientConfig := range cluster.Spec.ManagedClusterClientConfigs {
+		servers = append(servers, clientConfig.URL)
+	}
+
+	var result []string
+	for name, kubeContext := range kubeconfig.Contexts {
+		if name == cluster.Name {
+			result = append(result, name)
+			continue
+		}
+		if kubeCluster, ok := kubeconfig.Clusters[kubeContext.Cluster]; ok &&
+			slices.Contains(servers, kubeCluster.Server) {
+			result = append(result, name)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
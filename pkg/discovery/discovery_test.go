@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"bytes"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	ocmv1 "open-cluster-management.io/api/cluster/v1"
+
+	"github.com/ramendr/ramenctl/pkg/config"
+	"github.com/ramendr/ramenctl/pkg/helpers"
+)
+
+func testResult() *Result {
+	return &Result{
+		Hub: config.Cluster{Kubeconfig: "kubeconfig", Context: "hub"},
+		ClusterSets: []ClusterSet{
+			{Name: "default", Clusters: []string{"hub"}},
+			{Name: "dr-clusters", Clusters: []string{"dr1", "dr2"}},
+		},
+		DRPolicies: []DRPolicy{
+			{
+				Name:           "dr-policy",
+				Clusters:       []string{"dr1", "dr2"},
+				StorageClasses: []string{"rook-ceph-block", "rook-cephfs-fs1"},
+			},
+		},
+		Contexts: []string{"dr1", "hub", "my-dr2"},
+		ClusterContexts: map[string][]string{
+			"dr1": {"dr1"},
+			"dr2": {"my-dr2"},
+		},
+	}
+}
+
+func TestSampleAuto(t *testing.T) {
+	sample, err := testResult().Sample("ramenctl", Auto{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &config.Sample{
+		CommandName:            "ramenctl",
+		HubKubeconfig:          "kubeconfig",
+		HubContext:             "hub",
+		PrimaryKubeconfig:      "kubeconfig",
+		PrimaryContext:         "dr1",
+		SecondaryKubeconfig:    "kubeconfig",
+		SecondaryContext:       "my-dr2",
+		ClusterSet:             "dr-clusters",
+		DRPolicy:               "dr-policy",
+		RBDStorageClassName:    "rook-ceph-block",
+		CephFSStorageClassName: "rook-cephfs-fs1",
+	}
+	if !reflect.DeepEqual(expected, sample) {
+		diff := helpers.UnifiedDiff(t, expected, sample)
+		t.Fatalf("samples not equal\n%s", diff)
+	}
+}
+
+func TestSampleNoMatchingContext(t *testing.T) {
+	result := testResult()
+	delete(result.ClusterContexts, "dr2")
+	sample, err := result.Sample("ramenctl", Auto{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sample.SecondaryKubeconfig != "my-dr2.yaml" || sample.SecondaryContext != "" {
+		t.Fatalf("unexpected secondary cluster kubeconfig %q context %q",
+			sample.SecondaryKubeconfig, sample.SecondaryContext)
+	}
+}
+
+func TestSampleNoDRPolicy(t *testing.T) {
+	result := testResult()
+	result.DRPolicies = nil
+	if _, err := result.Sample("ramenctl", Auto{}); err == nil {
+		t.Fatal("sample without drpolicy did not fail")
+	}
+}
+
+func TestSampleNoClusterSet(t *testing.T) {
+	result := testResult()
+	result.ClusterSets = result.ClusterSets[:1]
+	if _, err := result.Sample("ramenctl", Auto{}); err == nil {
+		t.Fatal("sample without clusterset did not fail")
+	}
+}
+
+func TestSamplePrompt(t *testing.T) {
+	// Choose the hub context for dr1, the default for dr2, and swap the storage classes.
+	in := strings.NewReader("2\n\n2\n1\n")
+	var out bytes.Buffer
+	sample, err := testResult().Sample("ramenctl", NewPrompt(in, &out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sample.PrimaryContext != "hub" {
+		t.Errorf("expected primary context %q, got %q", "hub", sample.PrimaryContext)
+	}
+	if sample.SecondaryContext != "my-dr2" {
+		t.Errorf("expected secondary context %q, got %q", "my-dr2", sample.SecondaryContext)
+	}
+	if sample.RBDStorageClassName != "rook-cephfs-fs1" {
+		t.Errorf("unexpected rbd storage class %q", sample.RBDStorageClassName)
+	}
+	if sample.CephFSStorageClassName != "rook-ceph-block" {
+		t.Errorf("unexpected cephfs storage class %q", sample.CephFSStorageClassName)
+	}
+}
+
+func TestPromptInvalidChoice(t *testing.T) {
+	in := strings.NewReader("0\nfoo\n3\n")
+	var out bytes.Buffer
+	i, err := NewPrompt(in, &out).Choose("Options", []string{"a", "b", "c"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i != 2 {
+		t.Fatalf("expected choice 2, got %d", i)
+	}
+	if n := strings.Count(out.String(), "Invalid choice"); n != 2 {
+		t.Fatalf("expected 2 invalid choices, got %d\n%s", n, out.String())
+	}
+}
+
+func TestPromptEOF(t *testing.T) {
+	var out bytes.Buffer
+	if _, err := NewPrompt(strings.NewReader(""), &out).Choose("Options",
+		[]string{"a", "b"}, 0); err == nil {
+		t.Fatal("choose at end of input did not fail")
+	}
+}
+
+func TestMatchingContexts(t *testing.T) {
+	kubeconfig := &clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"hub-cluster": {Server: "https://hub:6443"},
+			"dr1-cluster": {Server: "https://dr1:6443"},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"hub":       {Cluster: "hub-cluster"},
+			"admin@dr1": {Cluster: "dr1-cluster"},
+			"dr1":       {Cluster: "other"},
+		},
+	}
+	cluster := &ocmv1.ManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "dr1"},
+		Spec: ocmv1.ManagedClusterSpec{
+			ManagedClusterClientConfigs: []ocmv1.ClientConfig{{URL: "https://dr1:6443"}},
+		},
+	}
+	contexts := matchingContexts(kubeconfig, cluster)
+	if !slices.Equal(contexts, []string{"admin@dr1", "dr1"}) {
+		t.Fatalf("unexpected contexts %q", contexts)
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Chooser chooses one of the discovered options.
+type Chooser interface {
+	// Choose returns the index of the chosen option. Options are never empty.
+	Choose(title string, options []string, defaultIndex int) (int, error)
+}
+
+// Auto chooses the default option without asking the user.
+type Auto struct{}
+
+func (Auto) Choose(title string, options []string, defaultIndex int) (int, error) {
+	return defaultIndex, nil
+}
+
+// Prompt asks the user to choose an option.
+type Prompt struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+// NewPrompt returns a prompt reading the user choices from in and writing the options to out.
+func NewPrompt(in io.Reader, out io.Writer) *Prompt {
+	return &Prompt{in: bufio.NewReader(in), out: out}
+}
+
+// Choose prints the numbered options and reads the user choice. An empty choice selects the default
+// option. A single option is chosen without asking the user.
+func (p *Prompt) Choose(title string, options []string, defaultIndex int) (int, error) {
+	if len(options) == 1 {
+		return 0, nil
+	}
+
+	fmt.Fprintf(p.out, "\n%s:\n", title)
+	for i, option := range options {
+		fmt.Fprintf(p.out, "  %d) %s\n", i+1, option)
+	}
+
+	for {
+		fmt.Fprintf(p.out, "Choose [%d]: ", defaultIndex+1)
+		line, err := p.in.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			return 0, fmt.Errorf("failed to read choice: %w", err)
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			return defaultIndex, nil
+		}
+		n, err := strconv.Atoi(line)
+		if err == nil && n >= 1 && n <= len(options) {
+			return n - 1, nil
+		}
+		fmt.Fprintf(p.out, "Invalid choice %q, enter a number between 1 and %d\n",
+			line, len(options))
+	}
+}
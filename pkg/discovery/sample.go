@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ramendr/ramenctl/pkg/config"
+	"github.com/ramendr/ramenctl/pkg/console"
+)
+
+// noContext is the option for setting the managed cluster kubeconfig later.
+const noContext = "(none) - set the kubeconfig later"
+
+// CreateConfig creates a configuration file with the configuration discovered from the hub.
+func CreateConfig(
+	ctx context.Context,
+	filename, commandName string,
+	hub config.Cluster,
+	chooser Chooser,
+) error {
+	// Fail before discovery and asking the user.
+	if _, err := os.Stat(filename); err == nil {
+		return fmt.Errorf("configuration file %q already exists", filename)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to check %q: %w", filename, err)
+	}
+
+	result, err := Discover(ctx, hub.Kubeconfig, hub.Context)
+	if err != nil {
+		return err
+	}
+	console.Info("Discovered hub context %q", result.Hub.Context)
+
+	sample, err := result.Sample(commandName, chooser)
+	if err != nil {
+		return err
+	}
+
+	return config.WriteSample(filename, sample)
+}
+
+// Sample returns a sample configuration with the discovered values. When there is more than one
+// option the chooser chooses the value.
+func (r *Result) Sample(commandName string, chooser Chooser) (*config.Sample, error) {
+	sample := config.NewSample(commandName)
+	sample.HubKubeconfig = r.Hub.Kubeconfig
+	sample.HubContext = r.Hub.Context
+
+	drPolicy, err := r.chooseDRPolicy(chooser)
+	if err != nil {
+		return nil, err
+	}
+	sample.DRPolicy = drPolicy.Name
+	console.Info("Using DRPolicy %q with clusters %q", drPolicy.Name, drPolicy.Clusters)
+
+	clusterSet, err := r.chooseClusterSet(drPolicy, chooser)
+	if err != nil {
+		return nil, err
+	}
+	sample.ClusterSet = clusterSet.Name
+	console.Info("Using clusterSet %q", clusterSet.Name)
+
+	primary, err := r.chooseCluster(drPolicy.Clusters[0], chooser)
+	if err != nil {
+		return nil, err
+	}
+	sample.PrimaryKubeconfig = primary.Kubeconfig
+	sample.PrimaryContext = primary.Context
+
+	secondary, err := r.chooseCluster(drPolicy.Clusters[1], chooser)
+	if err != nil {
+		return nil, err
+	}
+	sample.SecondaryKubeconfig = secondary.Kubeconfig
+	sample.SecondaryContext = secondary.Context
+
+	if len(drPolicy.StorageClasses) == 0 {
+		console.Info("No storage classes in DRPolicy %q peer classes, using defaults",
+			drPolicy.Name)
+		return sample, nil
+	}
+	console.Info("Found storage classes %q", drPolicy.StorageClasses)
+
+	rbd, err := chooseStorageClass(drPolicy.StorageClasses, "ReadWriteOnce", false, chooser)
+	if err != nil {
+		return nil, err
+	}
+	sample.RBDStorageClassName = rbd
+
+	cephfs, err := chooseStorageClass(drPolicy.StorageClasses, "ReadWriteMany", true, chooser)
+	if err != nil {
+		return nil, err
+	}
+	sample.CephFSStorageClassName = cephfs
+
+	return sample, nil
+}
+
+func (r *Result) chooseDRPolicy(chooser Chooser) (*DRPolicy, error) {
+	var drPolicies []*DRPolicy
+	var options []string
+	for i := range r.DRPolicies {
+		drPolicy := &r.DRPolicies[i]
+		if len(drPolicy.Clusters) != 2 {
+			continue
+		}
+		drPolicies = append(drPolicies, drPolicy)
+		options = append(options, fmt.Sprintf("%s (clusters: %s)",
+			drPolicy.Name, strings.Join(drPolicy.Clusters, ", ")))
+	}
+	if len(drPolicies) == 0 {
+		return nil, errors.New("no DRPolicy with 2 clusters found on the hub")
+	}
+
+	i, err := chooser.Choose("DRPolicies", options, 0)
+	if err != nil {
+		return nil, err
+	}
+	return drPolicies[i], nil
+}
+
+func (r *Result) chooseClusterSet(drPolicy *DRPolicy, chooser Chooser) (*ClusterSet, error) {
+	clusterSets := r.ClusterSetsWith(drPolicy.Clusters)
+	if len(clusterSets) == 0 {
+		return nil, fmt.Errorf("no ManagedClusterSet with DRPolicy %q clusters %q",
+			drPolicy.Name, drPolicy.Clusters)
+	}
+
+	var options []string
+	for _, clusterSet := range clusterSets {
+		options = append(options, clusterSet.Name)
+	}
+	i, err := chooser.Choose("ManagedClusterSets", options, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterSets[i], nil
+}
+
+// chooseCluster chooses the kubeconfig context for the managed cluster. Contexts matching the
+// cluster are listed first. If no context was chosen, the kubeconfig must be set later.
+func (r *Result) chooseCluster(name string, chooser Chooser) (config.Cluster, error) {
+	matching := r.ClusterContexts[name]
+	options := slices.Clone(matching)
+	for _, kubeContext := range r.Contexts {
+		if !slices.Contains(matching, kubeContext) {
+			options = append(options, kubeContext)
+		}
+	}
+	options = append(options, noContext)
+
+	defaultIndex := len(options) - 1
+	if len(matching) > 0 {
+		defaultIndex = 0
+	}
+
+	title := fmt.Sprintf("Kubeconfig contexts for cluster %q", name)
+	i, err := chooser.Choose(title, options, defaultIndex)
+	if err != nil {
+		return config.Cluster{}, err
+	}
+
+	if options[i] == noContext {
+		kubeconfig := fmt.Sprintf("my-%s.yaml", name)
+		console.Info("Using kubeconfig %q for cluster %q - please modify for your cluster",
+			kubeconfig, name)
+		return config.Cluster{Kubeconfig: kubeconfig}, nil
+	}
+
+	console.Info("Using context %q for cluster %q", options[i], name)
+	return config.Cluster{Kubeconfig: r.Hub.Kubeconfig, Context: options[i]}, nil
+}
+
+// chooseStorageClass chooses the storage class for testing PVCs with accessMode. The default is
+// the first CephFS storage class for shared PVCs, and the first other storage class otherwise.
+func chooseStorageClass(
+	storageClasses []string,
+	accessMode string,
+	shared bool,
+	chooser Chooser,
+) (string, error) {
+	defaultIndex := slices.IndexFunc(storageClasses, func(name string) bool {
+		return strings.Contains(name, "cephfs") == shared
+	})
+	if defaultIndex == -1 {
+		defaultIndex = 0
+	}
+
+	title := fmt.Sprintf("Storage classes for %s PVCs", accessMode)
+	i, err := chooser.Choose(title, storageClasses, defaultIndex)
+	if err != nil {
+		return "", err
+	}
+	console.Info("Using storage class %q for %s PVCs", storageClasses[i], accessMode)
+	return storageClasses[i], nil
+}
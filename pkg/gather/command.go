@@ -62,6 +62,10 @@ func (c *Command) Env() *types.Env {
 	return c.command.Env()
 }
 
+func (c *Command) ManagedClusters() []*types.Cluster {
+	return c.command.ManagedClusters()
+}
+
 func (c *Command) Config() *config.Config {
 	return c.config
 }
@@ -131,7 +135,7 @@ func (c *Command) gatherData() bool {
 	console.Step("Gather application data")
 	c.startStep("gather data")
 
-	namespaces, ok := c.inspectApplication()
+	namespaces, clusters, ok := c.inspectApplication()
 	if !ok {
 		return c.finishStep()
 	}
@@ -141,7 +145,7 @@ func (c *Command) gatherData() bool {
 		Namespaces: namespaces,
 		OutputDir:  c.dataDir(),
 	}
-	if !c.gatherApplication(options) {
+	if !c.gatherApplication(clusters, options) {
 		return c.finishStep()
 	}
 
@@ -158,12 +162,16 @@ func (c *Command) gatherData() bool {
 	return true
 }
 
-func (c *Command) inspectApplication() ([]string, bool) {
+func (c *Command) inspectApplication() ([]string, []*types.Cluster, bool) {
 	start := time.Now()
 	step := &report.Step{Name: "inspect application"}
 	c.Logger().Infof("Step %q started", step.Name)
 
-	namespaces, err := c.namespacesToGather()
+	var namespaces []string
+	clusters, err := c.clustersToGather()
+	if err == nil {
+		namespaces, err = c.namespacesToGather()
+	}
 	if err != nil {
 		step.Duration = time.Since(start).Seconds()
 		if errors.Is(err, context.Canceled) {
@@ -181,7 +189,7 @@ func (c *Command) inspectApplication() ([]string, bool) {
 		c.Logger().Errorf("Step %q %s: %s", c.current.Name, step.Status, err)
 		c.current.AddStep(step)
 
-		return nil, false
+		return nil, nil, false
 	}
 
 	step.Duration = time.Since(start).Seconds()
@@ -191,13 +199,15 @@ func (c *Command) inspectApplication() ([]string, bool) {
 	console.Pass("Inspected application")
 	c.Logger().Infof("Step %q passed", step.Name)
 
-	return namespaces, true
+	return namespaces, clusters, true
 }
 
-func (c *Command) gatherApplication(options gathering.Options) bool {
+func (c *Command) gatherApplication(
+	managedClusters []*types.Cluster,
+	options gathering.Options,
+) bool {
 	start := time.Now()
-	env := c.Env()
-	clusters := []*types.Cluster{env.Hub, env.C1, env.C2}
+	clusters := append([]*types.Cluster{c.Env().Hub}, managedClusters...)
 
 	c.Logger().Infof("Gathering from clusters %q with options %+v",
 		logging.ClusterNames(clusters), options)
@@ -280,7 +290,7 @@ func (c *Command) gatherApplicationS3Data(profiles []*s3.Profile, prefix string)
 		logging.ProfileNames(profiles), prefix)
 
 	var failedProfiles []string
-	for r := range c.backend.GatherS3(c, profiles, []string{prefix}, outputDir) {
+	for r := range c.backend.GatherS3(c, profiles, []string{prefix}, outputDir, c.opts.S3) {
 		step := &report.Step{
 			Name:     fmt.Sprintf("gather S3 profile %q", r.ProfileName),
 			Duration: r.Duration,
@@ -342,11 +352,30 @@ func (c *Command) passed() {
 	console.Completed("Gather completed")
 }
 
+// clustersToGather returns the managed clusters referenced by the application DRPolicy.
+func (c *Command) clustersToGather() ([]*types.Cluster, error) {
+	names, err := c.backend.ApplicationClusters(c, c.opts.DRPCName, c.opts.DRPCNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var clusters []*types.Cluster
+	for _, name := range names {
+		cluster, err := ramen.ManagedCluster(c, name)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters, nil
+}
+
 func (c *Command) namespacesToGather() ([]string, error) {
-	set := map[string]struct{}{
-		// Gather ramen namespaces to get ramen hub and dr-cluster logs and related resources.
-		c.config.Namespaces.RamenHubNamespace:       {},
-		c.config.Namespaces.RamenDRClusterNamespace: {},
+	// Gather ramen namespaces to get ramen hub and dr-cluster logs and related resources.
+	set := map[string]struct{}{}
+	for _, ns := range ramen.OperatorNamespaces(c.config) {
+		set[ns] = struct{}{}
 	}
 
 	appNamespaces, err := c.backend.ApplicationNamespaces(c, c.opts.DRPCName, c.opts.DRPCNamespace)
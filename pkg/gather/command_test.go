@@ -7,7 +7,6 @@ import (
 	"slices"
 	"testing"
 
-	e2econfig "github.com/ramendr/ramen/e2e/config"
 	"github.com/ramendr/ramen/e2e/types"
 
 	"github.com/ramendr/ramenctl/pkg/command"
@@ -27,7 +26,7 @@ const (
 
 var (
 	testConfig = &config.Config{
-		Namespaces: e2econfig.K8sNamespaces,
+		Namespaces: config.K8sNamespaces,
 	}
 
 	testEnv = &types.Env{
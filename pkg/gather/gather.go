@@ -13,7 +13,7 @@ import (
 )
 
 func Gather(opts command.ApplicationOptions) error {
-	config, err := config.ReadConfig(opts.ConfigFile)
+	config, err := config.ReadConfig(opts.ConfigFile, opts.Environment)
 	if err != nil {
 		return console.Failed(fmt.Errorf("unable to read config: %w", err))
 	}
@@ -25,6 +25,9 @@ const (
 
 	FakeAWSKeyIDFingerprint = "F3:1C:B8:5A:2C:33:BA:C3:57:84:22:D5:11:F5:35:40:FF:A8:6A:34:B8:CD:42:AC:86:65:E2:2B:E1:05:EA:23"
 	FakeAWSKeyFingerprint   = "BC:42:FE:14:DB:F0:91:1C:91:1F:8F:CF:72:AF:CE:C5:83:5C:AF:93:AC:08:40:CE:31:D8:67:CA:AC:BC:E4:16"
+
+	// FakeS3Objects is the number of objects found when gathering S3 data successfully.
+	FakeS3Objects = 10
 )
 
 func MarshalYAML(t *testing.T, a any) string {
@@ -36,7 +39,12 @@ func MarshalYAML(t *testing.T, a any) string {
 }
 
 func FakeTime(t *testing.T) {
-	fakeTime := time.Now()
+	FakeTimeAt(t, time.Now())
+}
+
+// FakeTimeAt fakes the current time to the specified time during the test. Use to validate
+// gathered data with time dependent values.
+func FakeTimeAt(t *testing.T, fakeTime time.Time) {
 	savedNow := time.Now
 	time.Now = func() time.Time {
 		return fakeTime
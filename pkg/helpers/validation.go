@@ -22,8 +22,9 @@ import (
 type ValidationMock struct {
 	ValidateFunc              func(validation.Context) error
 	ApplicationNamespacesFunc func(ctx validation.Context, drpcName, drpcNamespace string) ([]string, error)
+	ApplicationClustersFunc   func(ctx validation.Context, drpcName, drpcNamespace string) ([]string, error)
 	GatherFunc                func(ctx validation.Context, clsuters []*types.Cluster, options gathering.Options) <-chan gathering.Result
-	GatherS3Func              func(ctx validation.Context, profiles []*s3.Profile, prefixes []string, outputDir string) <-chan s3.Result
+	GatherS3Func              func(ctx validation.Context, profiles []*s3.Profile, prefixes []string, outputDir string, options s3.GatherOptions) <-chan s3.Result
 	GetSecretFunc             func(ctx validation.Context, cluster *types.Cluster, name, namespace string) (*corev1.Secret, error)
 	CheckS3Func               func(ctx validation.Context, profiles []*s3.Profile) <-chan s3.Result
 }
@@ -47,6 +48,20 @@ func (m *ValidationMock) ApplicationNamespaces(
 	return nil, nil
 }
 
+func (m *ValidationMock) ApplicationClusters(
+	ctx validation.Context,
+	drpcName, drpcNamespace string,
+) ([]string, error) {
+	if m.ApplicationClustersFunc != nil {
+		return m.ApplicationClustersFunc(ctx, drpcName, drpcNamespace)
+	}
+	var names []string
+	for _, cluster := range ctx.ManagedClusters() {
+		names = append(names, cluster.Name)
+	}
+	return names, nil
+}
+
 func (m *ValidationMock) Gather(
 	ctx validation.Context,
 	clusters []*types.Cluster,
@@ -85,9 +100,10 @@ func (m *ValidationMock) GatherS3(
 	profiles []*s3.Profile,
 	prefixes []string,
 	outputDir string,
+	options s3.GatherOptions,
 ) <-chan s3.Result {
 	if m.GatherS3Func != nil {
-		return m.GatherS3Func(ctx, profiles, prefixes, outputDir)
+		return m.GatherS3Func(ctx, profiles, prefixes, outputDir, options)
 	}
 	results := make(chan s3.Result, len(profiles))
 	for _, profile := range profiles {
@@ -95,7 +111,7 @@ func (m *ValidationMock) GatherS3(
 			!bytes.Equal(profile.AWSSecretAccessKey, []byte(FakeAWSKey)) {
 			results <- s3.Result{ProfileName: profile.Name, Err: errors.New("invalid credentials")}
 		} else {
-			results <- s3.Result{ProfileName: profile.Name, Err: nil}
+			results <- s3.Result{ProfileName: profile.Name, Objects: FakeS3Objects}
 		}
 	}
 	close(results)
@@ -188,13 +204,14 @@ func GatherS3DataFailed(
 	profiles []*s3.Profile,
 	prefixes []string,
 	outputDir string,
+	options s3.GatherOptions,
 ) <-chan s3.Result {
 	results := make(chan s3.Result, 2)
 	for i, profile := range profiles {
 		if i == 0 {
 			results <- s3.Result{ProfileName: profile.Name, Err: errors.New("no S3 data for you")}
 		} else {
-			results <- s3.Result{ProfileName: profile.Name}
+			results <- s3.Result{ProfileName: profile.Name, Objects: FakeS3Objects}
 		}
 	}
 	close(results)
@@ -206,13 +223,14 @@ func GatherS3DataCanceled(
 	profiles []*s3.Profile,
 	prefixes []string,
 	outputDir string,
+	options s3.GatherOptions,
 ) <-chan s3.Result {
 	results := make(chan s3.Result, 2)
 	for i, profile := range profiles {
 		if i == 0 {
 			results <- s3.Result{ProfileName: profile.Name, Err: context.Canceled}
 		} else {
-			results <- s3.Result{ProfileName: profile.Name}
+			results <- s3.Result{ProfileName: profile.Name, Objects: FakeS3Objects}
 		}
 	}
 	close(results)
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+)
+
+const (
+	// BackupSchedule and Restore phases.
+	BackupSchedulePhaseEnabled = "Enabled"
+	RestorePhaseEnabled        = "Enabled"
+	RestorePhaseFinished       = "Finished"
+
+	// BackupPhaseCompleted is the phase of a successful velero backup.
+	BackupPhaseCompleted = "Completed"
+
+	// We don't depend on the cluster-backup and velero apis, the resources are parsed to local
+	// types.
+	backupScheduleResource = "cluster.open-cluster-management.io/backupschedules"
+	restoreResource        = "cluster.open-cluster-management.io/restores"
+	backupResource         = "velero.io/backups"
+)
+
+// BackupSchedule is the part of the hub BackupSchedule used to validate the hub backup.
+type BackupSchedule struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              BackupScheduleSpec   `json:"spec"`
+	Status            BackupScheduleStatus `json:"status"`
+}
+
+// BackupScheduleSpec is the spec of a BackupSchedule.
+type BackupScheduleSpec struct {
+	VeleroSchedule string `json:"veleroSchedule,omitempty"`
+}
+
+// BackupScheduleStatus is the status of a BackupSchedule.
+type BackupScheduleStatus struct {
+	Phase       string `json:"phase,omitempty"`
+	LastMessage string `json:"lastMessage,omitempty"`
+}
+
+// Restore is the part of the hub Restore used to validate the passive hub.
+type Restore struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              RestoreSpec   `json:"spec"`
+	Status            RestoreStatus `json:"status"`
+}
+
+// RestoreSpec is the spec of a Restore.
+type RestoreSpec struct {
+	SyncRestoreWithNewBackups bool `json:"syncRestoreWithNewBackups,omitempty"`
+}
+
+// RestoreStatus is the status of a Restore.
+type RestoreStatus struct {
+	Phase       string `json:"phase,omitempty"`
+	LastMessage string `json:"lastMessage,omitempty"`
+}
+
+// Backup is the part of the velero Backup used to find the last successful hub backup.
+type Backup struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Status            BackupStatus `json:"status"`
+}
+
+// BackupStatus is the status of a velero Backup.
+type BackupStatus struct {
+	Phase               string       `json:"phase,omitempty"`
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+}
+
+// ListBackupSchedules lists the backup schedules in the backup namespace from the hub output
+// directory.
+func ListBackupSchedules(reader gathering.OutputReader, namespace string) ([]string, error) {
+	return reader.ListResources(namespace, backupScheduleResource)
+}
+
+// ReadBackupSchedule reads a backup schedule in the backup namespace from the hub output directory.
+func ReadBackupSchedule(
+	reader gathering.OutputReader,
+	namespace, name string,
+) (*BackupSchedule, error) {
+	data, err := reader.ReadResource(namespace, backupScheduleResource, name)
+	if err != nil {
+		return nil, err
+	}
+	schedule := &BackupSchedule{}
+	if err := yaml.Unmarshal(data, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// ListRestores lists the restores in the backup namespace from the hub output directory.
+func ListRestores(reader gathering.OutputReader, namespace string) ([]string, error) {
+	return reader.ListResources(namespace, restoreResource)
+}
+
+// ReadRestore reads a restore in the backup namespace from the hub output directory.
+func ReadRestore(reader gathering.OutputReader, namespace, name string) (*Restore, error) {
+	data, err := reader.ReadResource(namespace, restoreResource, name)
+	if err != nil {
+		return nil, err
+	}
+	restore := &Restore{}
+	if err := yaml.Unmarshal(data, restore); err != nil {
+		return nil, err
+	}
+	return restore, nil
+}
+
+// LastBackupTime returns the completion time of the last successful hub backup in the backup
+// namespace in the hub output directory, or nil if no backup completed.
+func LastBackupTime(reader gathering.OutputReader, namespace string) (*time.Time, error) {
+	names, err := reader.ListResources(namespace, backupResource)
+	if err != nil {
+		return nil, err
+	}
+
+	var last *time.Time
+	for _, name := range names {
+		data, err := reader.ReadResource(namespace, backupResource, name)
+		if err != nil {
+			return nil, err
+		}
+		backup := &Backup{}
+		if err := yaml.Unmarshal(data, backup); err != nil {
+			return nil, err
+		}
+		if backup.Status.Phase != BackupPhaseCompleted || backup.Status.CompletionTimestamp == nil {
+			continue
+		}
+		if last == nil || backup.Status.CompletionTimestamp.After(*last) {
+			last = &backup.Status.CompletionTimestamp.Time
+		}
+	}
+
+	return last, nil
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	ocmworkv1 "open-cluster-management.io/api/work/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+)
+
+const manifestWorkResource = ocmworkv1.GroupName + "/manifestworks"
+
+// ReadManifestWork reads a manifest work from the managed cluster namespace in the hub output
+// directory.
+func ReadManifestWork(
+	reader gathering.OutputReader,
+	name, clusterName string,
+) (*ocmworkv1.ManifestWork, error) {
+	data, err := reader.ReadResource(clusterName, manifestWorkResource, name)
+	if err != nil {
+		return nil, err
+	}
+	work := &ocmworkv1.ManifestWork{}
+	if err := yaml.Unmarshal(data, work); err != nil {
+		return nil, err
+	}
+	return work, nil
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	coordinationv1 "k8s.io/api/coordination/v1"
+	ocmaddonv1a1 "open-cluster-management.io/api/addon/v1alpha1"
+	ocmv1 "open-cluster-management.io/api/cluster/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+)
+
+const (
+	// ManagedClusterLeaseName is the name of the lease updated by the klusterlet agent in the
+	// managed cluster namespace on the hub.
+	ManagedClusterLeaseName = "managed-cluster-lease"
+
+	// DefaultLeaseDurationSeconds is used when the ManagedCluster does not specify
+	// leaseDurationSeconds.
+	DefaultLeaseDurationSeconds = 60
+
+	// LeaseGracePeriod is the number of lease durations after which the hub marks the managed
+	// cluster as unavailable.
+	LeaseGracePeriod = 5
+
+	managedClusterResource      = ocmv1.GroupName + "/managedclusters"
+	managedClusterAddOnResource = ocmaddonv1a1.GroupName + "/managedclusteraddons"
+	leaseResource               = coordinationv1.GroupName + "/leases"
+)
+
+// RamenAddOns are the ManagedClusterAddOns used by ramen. Addons not installed on a cluster are
+// not validated, since they are required only for some applications.
+var RamenAddOns = []string{
+	// Required for subscription based applications.
+	"application-manager",
+	// Required for applications protected by volsync.
+	"volsync",
+}
+
+// ListManagedClusters lists the managed clusters from the hub output directory.
+func ListManagedClusters(reader gathering.OutputReader) ([]string, error) {
+	return reader.ListResources("", managedClusterResource)
+}
+
+// ReadManagedCluster reads a managed cluster from the hub output directory.
+func ReadManagedCluster(
+	reader gathering.OutputReader,
+	name string,
+) (*ocmv1.ManagedCluster, error) {
+	data, err := reader.ReadResource("", managedClusterResource, name)
+	if err != nil {
+		return nil, err
+	}
+	cluster := &ocmv1.ManagedCluster{}
+	if err := yaml.Unmarshal(data, cluster); err != nil {
+		return nil, err
+	}
+	return cluster, nil
+}
+
+// ReadManagedClusterLease reads the managed cluster lease from the hub output directory.
+func ReadManagedClusterLease(
+	reader gathering.OutputReader,
+	clusterName string,
+) (*coordinationv1.Lease, error) {
+	data, err := reader.ReadResource(clusterName, leaseResource, ManagedClusterLeaseName)
+	if err != nil {
+		return nil, err
+	}
+	lease := &coordinationv1.Lease{}
+	if err := yaml.Unmarshal(data, lease); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// ReadManagedClusterAddOn reads a managed cluster addon from the hub output directory.
+func ReadManagedClusterAddOn(
+	reader gathering.OutputReader,
+	name, clusterName string,
+) (*ocmaddonv1a1.ManagedClusterAddOn, error) {
+	data, err := reader.ReadResource(clusterName, managedClusterAddOnResource, name)
+	if err != nil {
+		return nil, err
+	}
+	addon := &ocmaddonv1a1.ManagedClusterAddOn{}
+	if err := yaml.Unmarshal(data, addon); err != nil {
+		return nil, err
+	}
+	return addon, nil
+}
+
+// LeaseDuration returns the lease duration in seconds for the managed cluster.
+func LeaseDuration(cluster *ocmv1.ManagedCluster) int32 {
+	if cluster.Spec.LeaseDurationSeconds > 0 {
+		return cluster.Spec.LeaseDurationSeconds
+	}
+	return DefaultLeaseDurationSeconds
+}
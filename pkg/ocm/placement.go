@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ocm
+
+import (
+	ocmv1b1 "open-cluster-management.io/api/cluster/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+)
+
+const (
+	// PlacementKind is the kind of OCM placement.
+	PlacementKind = "Placement"
+
+	placementResource         = ocmv1b1.GroupName + "/placements"
+	placementDecisionResource = ocmv1b1.GroupName + "/placementdecisions"
+)
+
+// ReadPlacement reads a placement from the hub output directory.
+func ReadPlacement(
+	reader gathering.OutputReader,
+	name, namespace string,
+) (*ocmv1b1.Placement, error) {
+	data, err := reader.ReadResource(namespace, placementResource, name)
+	if err != nil {
+		return nil, err
+	}
+	placement := &ocmv1b1.Placement{}
+	if err := yaml.Unmarshal(data, placement); err != nil {
+		return nil, err
+	}
+	return placement, nil
+}
+
+// PlacementDecisionClusters returns the names of the clusters selected by all placement decisions
+// of the placement in the hub output directory.
+func PlacementDecisionClusters(
+	reader gathering.OutputReader,
+	placement *ocmv1b1.Placement,
+) ([]string, error) {
+	names, err := reader.ListResources(placement.Namespace, placementDecisionResource)
+	if err != nil {
+		return nil, err
+	}
+	var clusters []string
+	for _, name := range names {
+		data, err := reader.ReadResource(placement.Namespace, placementDecisionResource, name)
+		if err != nil {
+			return nil, err
+		}
+		decision := &ocmv1b1.PlacementDecision{}
+		if err := yaml.Unmarshal(data, decision); err != nil {
+			return nil, err
+		}
+		if decision.Labels[ocmv1b1.PlacementLabel] != placement.Name {
+			continue
+		}
+		for _, d := range decision.Status.Decisions {
+			clusters = append(clusters, d.ClusterName)
+		}
+	}
+	return clusters, nil
+}
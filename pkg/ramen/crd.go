@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ramen
+
+import (
+	"fmt"
+	"strings"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+)
+
+const crdResource = "apiextensions.k8s.io/customresourcedefinitions"
+
+// crdFields are the spec and status fields read by ramenctl for every ramen CRD plural.
+var crdFields = map[string][]string{
+	drClusterPlural: {
+		"spec.s3ProfileName",
+		"spec.region",
+		"spec.clusterFence",
+		"spec.cidrs",
+		"status.phase",
+		"status.conditions",
+		"status.maintenanceModes",
+	},
+	drPolicyPlural: {
+		"spec.drClusters",
+		"spec.schedulingInterval",
+		"status.conditions",
+		"status.async.peerClasses",
+		"status.sync.peerClasses",
+	},
+	drpcPlural: {
+		"spec.drPolicyRef",
+		"spec.placementRef",
+		"spec.preferredCluster",
+		"spec.failoverCluster",
+		"spec.action",
+		"spec.pvcSelector",
+		"spec.protectedNamespaces",
+		"spec.kubeObjectProtection",
+		"status.phase",
+		"status.progression",
+		"status.actionStartTime",
+		"status.conditions",
+		"status.lastGroupSyncTime",
+	},
+	vrgPlural: {
+		"spec.replicationState",
+		"spec.s3Profiles",
+		"spec.async.schedulingInterval",
+		"status.state",
+		"status.conditions",
+		"status.protectedPVCs",
+		"status.lastGroupSyncTime",
+		"status.kubeObjectProtection.captureToRecoverFrom",
+	},
+}
+
+// CRDVersion is a version of a CRD.
+type CRDVersion struct {
+	Name    string            `json:"name"`
+	Served  bool              `json:"served"`
+	Storage bool              `json:"storage"`
+	Schema  *CRDVersionSchema `json:"schema,omitempty"`
+}
+
+// CRDVersionSchema is the validation schema of a CRD version.
+type CRDVersionSchema struct {
+	OpenAPIV3Schema *CRDSchema `json:"openAPIV3Schema,omitempty"`
+}
+
+// CRDSchema is the part of the OpenAPI v3 schema used to validate the CRD fields.
+type CRDSchema struct {
+	Properties             map[string]CRDSchema `json:"properties"`
+	Items                  *CRDSchema           `json:"items"`
+	XPreserveUnknownFields bool                 `json:"x-kubernetes-preserve-unknown-fields"`
+}
+
+// crd is the part of the CRD used to validate the CRD versions and schema. We don't use the
+// apiextensions types since we need only the versions.
+type crd struct {
+	Spec struct {
+		Versions []CRDVersion `json:"versions"`
+	} `json:"spec"`
+}
+
+// CRDNames returns the names of the ramen CRDs used by ramenctl for the given controller type.
+func CRDNames(controllerType ramenapi.ControllerType) []string {
+	group := ramenapi.GroupVersion.Group
+	switch controllerType {
+	case ramenapi.DRHubType:
+		return []string{
+			drClusterPlural + "." + group,
+			drPolicyPlural + "." + group,
+			drpcPlural + "." + group,
+		}
+	case ramenapi.DRClusterType:
+		return []string{
+			vrgPlural + "." + group,
+		}
+	default:
+		panic(fmt.Sprintf("Invalid controller type %q", controllerType))
+	}
+}
+
+// ListCRDs lists the CRDs from the output directory.
+func ListCRDs(reader gathering.OutputReader) ([]string, error) {
+	return reader.ListResources("", crdResource)
+}
+
+// ReadCRDVersions reads the CRD versions from the output directory.
+func ReadCRDVersions(reader gathering.OutputReader, name string) ([]CRDVersion, error) {
+	data, err := reader.ReadResource("", crdResource, name)
+	if err != nil {
+		return nil, err
+	}
+	c := &crd{}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c.Spec.Versions, nil
+}
+
+// MissingFields returns the fields read by ramenctl that are missing in the CRD version schema.
+func (v *CRDVersion) MissingFields(name string) []string {
+	plural, _, _ := strings.Cut(name, ".")
+	var schema *CRDSchema
+	if v.Schema != nil {
+		schema = v.Schema.OpenAPIV3Schema
+	}
+	var missing []string
+	for _, path := range crdFields[plural] {
+		if !schema.hasField(path) {
+			missing = append(missing, path)
+		}
+	}
+	return missing
+}
+
+// hasField returns true if the schema includes the field path. Array items are looked up in the
+// items schema, and fields under an object preserving unknown fields are always found.
+func (s *CRDSchema) hasField(path string) bool {
+	if s == nil {
+		return false
+	}
+	node := s
+	for _, name := range strings.Split(path, ".") {
+		for node.Items != nil {
+			node = node.Items
+		}
+		if node.XPreserveUnknownFields && len(node.Properties) == 0 {
+			return true
+		}
+		child, ok := node.Properties[name]
+		if !ok {
+			return false
+		}
+		node = &child
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ramen
+
+import (
+	"slices"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+const testDRPolicySchema = `
+name: v1alpha1
+served: true
+storage: true
+schema:
+  openAPIV3Schema:
+    type: object
+    properties:
+      spec:
+        type: object
+        properties:
+          drClusters:
+            type: array
+            items:
+              type: string
+          schedulingInterval:
+            type: string
+      status:
+        type: object
+        properties:
+          conditions:
+            type: array
+            items:
+              type: object
+          async:
+            type: object
+            properties:
+              peerClasses:
+                type: array
+                items:
+                  type: object
+          sync:
+            type: object
+            properties:
+              peerClasses:
+                type: array
+                items:
+                  type: object
+`
+
+func TestCRDMissingFieldsNone(t *testing.T) {
+	version := testCRDVersion(t, testDRPolicySchema)
+	if missing := version.MissingFields("drpolicies.ramendr.openshift.io"); missing != nil {
+		t.Fatalf("unexpected missing fields %q", missing)
+	}
+}
+
+func TestCRDMissingFields(t *testing.T) {
+	version := testCRDVersion(t, testDRPolicySchema)
+	properties := version.Schema.OpenAPIV3Schema.Properties
+	delete(properties["spec"].Properties, "schedulingInterval")
+	delete(properties["status"].Properties, "sync")
+	missing := version.MissingFields("drpolicies.ramendr.openshift.io")
+	expected := []string{"spec.schedulingInterval", "status.sync.peerClasses"}
+	if !slices.Equal(missing, expected) {
+		t.Fatalf("expected missing fields %q, got %q", expected, missing)
+	}
+}
+
+func TestCRDMissingFieldsNoSchema(t *testing.T) {
+	version := &CRDVersion{Name: "v1alpha1", Served: true, Storage: true}
+	missing := version.MissingFields("drpolicies.ramendr.openshift.io")
+	if len(missing) != len(crdFields[drPolicyPlural]) {
+		t.Fatalf("expected all fields missing, got %q", missing)
+	}
+}
+
+func TestCRDMissingFieldsPreserveUnknownFields(t *testing.T) {
+	version := testCRDVersion(t, `
+name: v1alpha1
+schema:
+  openAPIV3Schema:
+    type: object
+    properties:
+      spec:
+        type: object
+        x-kubernetes-preserve-unknown-fields: true
+      status:
+        type: object
+        x-kubernetes-preserve-unknown-fields: true
+`)
+	if missing := version.MissingFields("drpolicies.ramendr.openshift.io"); missing != nil {
+		t.Fatalf("unexpected missing fields %q", missing)
+	}
+}
+
+func testCRDVersion(t *testing.T, data string) *CRDVersion {
+	t.Helper()
+	version := &CRDVersion{}
+	if err := yaml.Unmarshal([]byte(data), version); err != nil {
+		t.Fatal(err)
+	}
+	return version
+}
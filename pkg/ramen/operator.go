@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ramen
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+
+	"github.com/ramendr/ramenctl/pkg/config"
+	"github.com/ramendr/ramenctl/pkg/sets"
+)
+
+const (
+	// OperatorDiscoveredByLabel is used for operators discovered by the deployment labels.
+	OperatorDiscoveredByLabel = "label"
+
+	// OperatorDiscoveredByCRDOwner is used for operators discovered by the operator owning the
+	// ramen CRDs.
+	OperatorDiscoveredByCRDOwner = "crd-owner"
+
+	// OperatorDiscoveredByDefault is used for operators that were not discovered.
+	OperatorDiscoveredByDefault = "default"
+
+	// operatorAppLabel is the label selecting the ramen operator deployments.
+	// https://github.com/RamenDR/ramen/blob/main/config/hub/manager/manager.yaml
+	operatorAppLabel = "app"
+
+	// OLM adds this label prefix to resources installed by an operator.
+	olmOperatorLabelPrefix = "operators.coreos.com/"
+
+	// OLM adds this label to the deployment, with the ClusterServiceVersion name (e.g.
+	// "odr-hub-operator.v4.20.0").
+	olmOwnerLabel = "olm.owner"
+)
+
+// OperatorLabels returns the labels selecting the operator deployment for the given controller
+// type.
+func OperatorLabels(controllerType ramenapi.ControllerType) map[string]string {
+	switch controllerType {
+	case ramenapi.DRHubType:
+		return map[string]string{operatorAppLabel: "ramen-hub"}
+	case ramenapi.DRClusterType:
+		return map[string]string{operatorAppLabel: "ramen-dr-cluster"}
+	default:
+		panic(fmt.Sprintf("Invalid controller type %q", controllerType))
+	}
+}
+
+// OperatorCRDName returns the name of a CRD installed by the operator for the given controller
+// type.
+func OperatorCRDName(controllerType ramenapi.ControllerType) string {
+	switch controllerType {
+	case ramenapi.DRHubType:
+		return drpcPlural + "." + ramenapi.GroupVersion.Group
+	case ramenapi.DRClusterType:
+		return vrgPlural + "." + ramenapi.GroupVersion.Group
+	default:
+		panic(fmt.Sprintf("Invalid controller type %q", controllerType))
+	}
+}
+
+// OperatorOwnerLabels returns the OLM operator labels from the CRD labels. The same labels are
+// added to the operator deployment.
+func OperatorOwnerLabels(crdLabels map[string]string) []string {
+	var labels []string
+	for key := range crdLabels {
+		if strings.HasPrefix(key, olmOperatorLabelPrefix) {
+			labels = append(labels, key)
+		}
+	}
+	slices.Sort(labels)
+	return labels
+}
+
+// Operator returns the ramen operator on the cluster. If the operator was not discovered, returns
+// the default operator for the controller type.
+func Operator(
+	cfg *config.Config,
+	clusterName string,
+	controllerType ramenapi.ControllerType,
+) config.Operator {
+	if operator, ok := cfg.Operators[clusterName]; ok {
+		return operator
+	}
+	return DefaultOperator(cfg, controllerType)
+}
+
+// DefaultOperator returns the default operator for the controller type.
+func DefaultOperator(cfg *config.Config, controllerType ramenapi.ControllerType) config.Operator {
+	operator := config.Operator{
+		Deployment:   OperatorDeploymentName(controllerType),
+		ConfigMap:    OperatorConfigMapName(controllerType),
+		Replicas:     OperatorReplicas,
+		DiscoveredBy: OperatorDiscoveredByDefault,
+	}
+	if controllerType == ramenapi.DRHubType {
+		operator.Namespace = cfg.Namespaces.RamenHubNamespace
+	} else {
+		operator.Namespace = cfg.Namespaces.RamenDRClusterNamespace
+	}
+	return operator
+}
+
+// OperatorFromDeployment returns the operator running the deployment. The configmap is looked up
+// in the manager container volumes, falling back to the default configmap for the controller type.
+func OperatorFromDeployment(
+	deployment *appsv1.Deployment,
+	controllerType ramenapi.ControllerType,
+	discoveredBy string,
+) config.Operator {
+	operator := config.Operator{
+		Deployment:   deployment.Name,
+		Namespace:    deployment.Namespace,
+		ConfigMap:    OperatorConfigMapName(controllerType),
+		Replicas:     OperatorReplicas,
+		DiscoveredBy: discoveredBy,
+	}
+	if deployment.Spec.Replicas != nil {
+		operator.Replicas = *deployment.Spec.Replicas
+	}
+	if name, ok := deploymentConfigMapName(deployment); ok {
+		operator.ConfigMap = name
+	}
+	return operator
+}
+
+// OperatorNamespaces returns the namespaces of all ramen operators, including the default ramen
+// namespaces.
+func OperatorNamespaces(cfg *config.Config) []string {
+	namespaces := []string{
+		cfg.Namespaces.RamenHubNamespace,
+		cfg.Namespaces.RamenDRClusterNamespace,
+	}
+	for _, operator := range cfg.Operators {
+		namespaces = append(namespaces, operator.Namespace)
+	}
+	return sets.Sorted(namespaces)
+}
+
+// OperatorVersion returns the manager container image and the operator version. The version is
+// taken from the OLM ClusterServiceVersion owning the deployment, or from the image tag. Returns an
+// empty version if the version cannot be determined.
+func OperatorVersion(deployment *appsv1.Deployment) (string, string) {
+	var image string
+	for i := range deployment.Spec.Template.Spec.Containers {
+		c := &deployment.Spec.Template.Spec.Containers[i]
+		if c.Name == ManagerContainerName {
+			image = c.Image
+			break
+		}
+	}
+	if owner := deployment.Labels[olmOwnerLabel]; owner != "" {
+		if _, version, found := strings.Cut(owner, ".v"); found {
+			return image, version
+		}
+	}
+	return image, imageTag(image)
+}
+
+// imageTag returns the image tag, or an empty string if the image is referenced by digest or has
+// no tag.
+func imageTag(image string) string {
+	if strings.Contains(image, "@") {
+		return ""
+	}
+	// The registry may include a port (e.g. "registry:5000/ramen"), so look only at the last
+	// path component.
+	name := image[strings.LastIndex(image, "/")+1:]
+	if _, tag, found := strings.Cut(name, ":"); found {
+		return tag
+	}
+	return ""
+}
+
+// deploymentConfigMapName returns the name of the configmap mounted in the manager container.
+func deploymentConfigMapName(deployment *appsv1.Deployment) (string, bool) {
+	spec := &deployment.Spec.Template.Spec
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		if c.Name != ManagerContainerName {
+			continue
+		}
+		for _, mount := range c.VolumeMounts {
+			for j := range spec.Volumes {
+				volume := &spec.Volumes[j]
+				if volume.Name == mount.Name && volume.ConfigMap != nil {
+					return volume.ConfigMap.Name, true
+				}
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ramen
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ramendr/ramen/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ramendr/ramenctl/pkg/config"
+	"github.com/ramendr/ramenctl/pkg/sets"
+)
+
+func TestOperatorDefault(t *testing.T) {
+	expected := config.Operator{
+		Deployment:   HubOperatorName,
+		Namespace:    testConfig.Namespaces.RamenHubNamespace,
+		ConfigMap:    HubOperatorConfigMapName,
+		Replicas:     OperatorReplicas,
+		DiscoveredBy: OperatorDiscoveredByDefault,
+	}
+	operator := Operator(testConfig, testHubName, v1alpha1.DRHubType)
+	if operator != expected {
+		t.Errorf("expected %+v, got %+v", expected, operator)
+	}
+}
+
+func TestOperatorDiscovered(t *testing.T) {
+	discovered := config.Operator{
+		Deployment:   "odr-hub-operator",
+		Namespace:    "openshift-operators",
+		ConfigMap:    "odr-hub-operator-config",
+		Replicas:     2,
+		DiscoveredBy: OperatorDiscoveredByLabel,
+	}
+	cfg := &config.Config{
+		Namespaces: testConfig.Namespaces,
+		Operators:  map[string]config.Operator{testHubName: discovered},
+	}
+	if operator := Operator(cfg, testHubName, v1alpha1.DRHubType); operator != discovered {
+		t.Errorf("expected %+v, got %+v", discovered, operator)
+	}
+	expected := DefaultOperator(cfg, v1alpha1.DRClusterType)
+	if operator := Operator(cfg, testPrimaryName, v1alpha1.DRClusterType); operator != expected {
+		t.Errorf("expected %+v, got %+v", expected, operator)
+	}
+}
+
+func TestOperatorFromDeployment(t *testing.T) {
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: v1meta.ObjectMeta{Name: "odr-hub-operator", Namespace: "openshift-operators"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "kube-rbac-proxy"},
+						{
+							Name: ManagerContainerName,
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "cert"},
+								{Name: "ramen-manager-config-vol"},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "cert",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{SecretName: "cert"},
+							},
+						},
+						{
+							Name: "ramen-manager-config-vol",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{
+										Name: "odr-hub-operator-config",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	expected := config.Operator{
+		Deployment:   "odr-hub-operator",
+		Namespace:    "openshift-operators",
+		ConfigMap:    "odr-hub-operator-config",
+		Replicas:     2,
+		DiscoveredBy: OperatorDiscoveredByCRDOwner,
+	}
+	operator := OperatorFromDeployment(deployment, v1alpha1.DRHubType, OperatorDiscoveredByCRDOwner)
+	if operator != expected {
+		t.Errorf("expected %+v, got %+v", expected, operator)
+	}
+}
+
+func TestOperatorFromDeploymentDefaults(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: v1meta.ObjectMeta{Name: "ramen-dr-cluster", Namespace: "ramen-system"},
+	}
+	expected := config.Operator{
+		Deployment:   "ramen-dr-cluster",
+		Namespace:    "ramen-system",
+		ConfigMap:    DrClusterOperatorConfigMapName,
+		Replicas:     OperatorReplicas,
+		DiscoveredBy: OperatorDiscoveredByLabel,
+	}
+	operator := OperatorFromDeployment(deployment, v1alpha1.DRClusterType, OperatorDiscoveredByLabel)
+	if operator != expected {
+		t.Errorf("expected %+v, got %+v", expected, operator)
+	}
+}
+
+func TestOperatorOwnerLabels(t *testing.T) {
+	labels := map[string]string{
+		"operators.coreos.com/odr-hub-operator.openshift-operators": "",
+		"app.kubernetes.io/name":                                    "ramen",
+	}
+	expected := []string{"operators.coreos.com/odr-hub-operator.openshift-operators"}
+	if owners := OperatorOwnerLabels(labels); !slices.Equal(owners, expected) {
+		t.Errorf("expected %q, got %q", expected, owners)
+	}
+}
+
+func TestOperatorNamespaces(t *testing.T) {
+	cfg := &config.Config{
+		Namespaces: testConfig.Namespaces,
+		Operators: map[string]config.Operator{
+			testHubName:     {Namespace: "openshift-operators"},
+			testPrimaryName: {Namespace: testConfig.Namespaces.RamenDRClusterNamespace},
+		},
+	}
+	expected := sets.Sorted([]string{
+		"openshift-operators",
+		testConfig.Namespaces.RamenHubNamespace,
+		testConfig.Namespaces.RamenDRClusterNamespace,
+	})
+	if namespaces := OperatorNamespaces(cfg); !slices.Equal(namespaces, expected) {
+		t.Errorf("expected %q, got %q", expected, namespaces)
+	}
+}
+
+func TestOperatorVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		image   string
+		version string
+	}{
+		{
+			name:    "image tag",
+			image:   "quay.io/ramendr/ramen-operator:v0.1.0",
+			version: "v0.1.0",
+		},
+		{
+			name:    "registry port",
+			image:   "registry:5000/ramen-operator",
+			version: "",
+		},
+		{
+			name:    "image digest",
+			image:   "registry.redhat.io/odf4/odr-rhel9-operator@sha256:1afeb70a0a04",
+			version: "",
+		},
+		{
+			name:    "olm owner",
+			labels:  map[string]string{"olm.owner": "odr-hub-operator.v4.20.0-78.stable"},
+			image:   "registry.redhat.io/odf4/odr-rhel9-operator@sha256:1afeb70a0a04",
+			version: "4.20.0-78.stable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment := &appsv1.Deployment{
+				ObjectMeta: v1meta.ObjectMeta{Labels: tt.labels},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{Name: ManagerContainerName, Image: tt.image},
+							},
+						},
+					},
+				},
+			}
+			image, version := OperatorVersion(deployment)
+			if image != tt.image {
+				t.Errorf("expected image %q, got %q", tt.image, image)
+			}
+			if version != tt.version {
+				t.Errorf("expected version %q, got %q", tt.version, version)
+			}
+		})
+	}
+}
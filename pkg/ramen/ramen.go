@@ -5,8 +5,10 @@ package ramen
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
+	"os"
 	"slices"
 	"time"
 
@@ -46,12 +48,12 @@ const (
 	// https://github.com/RamenDR/ramen/blob/eebc5c0cb46af2eea145e7d40feef09681f6b110/internal/controller/status.go#L55
 	VRGConditionReasonUnused = "Unused"
 
-	// HubOperatorName is the name of the deploymentg on the hub.
-	// TODO: discover the value from the cluster.
+	// HubOperatorName is the default name of the deployment on the hub, used if the operator was
+	// not discovered.
 	HubOperatorName = "ramen-hub-operator"
 
-	// DRClusterOperatorName is the name of the deploymentg on the managed clusters.
-	// TODO: discover the value from the cluster.
+	// DRClusterOperatorName is the default name of the deployment on the managed clusters, used if
+	// the operator was not discovered.
 	DRClusterOperatorName = "ramen-dr-cluster-operator"
 
 	// HubOperatorConfigMapName is the name of the ramen configmap on the hub.
@@ -66,8 +68,8 @@ const (
 	// https://github.com/RamenDR/ramen/blob/ac64bd0bb67bcb194b938d52dc86bd165807987e/internal/controller/ramenconfig.go#L35
 	ConfigMapRamenConfigKeyName = "ramen_manager_config.yaml"
 
-	// OperatorReplicas is the number of pods in the ramen operator deployment.
-	// TODO: discover the value from the cluster.
+	// OperatorReplicas is the default number of pods in the ramen operator deployment, used if the
+	// operator was not discovered.
 	OperatorReplicas = 1
 
 	// ControllerTypeEnvName is the environment variable name for the controller type
@@ -79,8 +81,23 @@ const (
 	// ManagerContainerName is the name of the manager container in the ramen operator deployment.
 	// TODO: consume from ramen: https://github.com/RamenDR/ramen/issues/2515
 	ManagerContainerName = "manager"
+
+	// VeleroNamespaceDefault is the velero namespace used by ramen if the ramen config does not
+	// specify veleroNamespaceName.
+	VeleroNamespaceDefault = "velero"
+
+	// VeleroDeploymentName is the name of the velero deployment in the velero namespace.
+	VeleroDeploymentName = "velero"
+
+	// KubeObjectsCaptureIntervalDefault is the kube objects capture interval used by ramen if the
+	// DRPC does not specify captureInterval.
+	KubeObjectsCaptureIntervalDefault = 5 * time.Minute
 )
 
+// VeleroNamespaces are the common velero namespaces, gathered for applications using kube object
+// protection. OpenShift uses the OADP operator namespace.
+var VeleroNamespaces = []string{VeleroNamespaceDefault, "openshift-adp"}
+
 const (
 	// Annotation added by kubectl-gather with the API server time when the resource was gathered.
 	clusterTimeAnnotation = "kubectl-gather.nirs.github.com/cluster-time"
@@ -91,12 +108,23 @@ const (
 
 	// TODO: find a way to get this from ramen api. Available in the CRD under spec/names/plural.
 	// Should we gather the CRDs from the cluster?
-	drpcPlural      = "drplacementcontrols"
-	vrgPlural       = "volumereplicationgroups"
-	drPolicyPlural  = "drpolicies"
-	drClusterPlural = "drclusters"
+	drpcPlural            = "drplacementcontrols"
+	vrgPlural             = "volumereplicationgroups"
+	drPolicyPlural        = "drpolicies"
+	drClusterPlural       = "drclusters"
+	maintenanceModePlural = "maintenancemodes"
+
+	// Ramen creates a NetworkFence with this prefix on the peer cluster to fence a DRCluster.
+	networkFenceNamePrefix = "network-fence-"
 
 	configMapNameSuffix = "-config"
+
+	// ManifestWork name format and types used by ramen hub to deliver resources to the managed
+	// clusters.
+	// https://github.com/RamenDR/ramen/blob/main/internal/controller/util/mw_util.go
+	manifestWorkNameFormat    = "%s-%s-%s-mw"
+	manifestWorkTypeVRG       = "vrg"
+	manifestWorkTypeNamespace = "ns"
 )
 
 // Actions are the valid DRPC and VRG actions.
@@ -116,6 +144,7 @@ var knownVRGConditionTypes = map[string]struct{}{
 
 type Context interface {
 	Env() *e2etypes.Env
+	ManagedClusters() []*e2etypes.Cluster
 	Context() context.Context
 	Config() *config.Config
 	OutputReader(cluster string) gathering.OutputReader
@@ -177,6 +206,14 @@ func ApplicationNamespaces(drpc *ramenapi.DRPlacementControl) []string {
 	if appNamespace := drpc.Annotations[drpcAppNamespaceAnnotation]; appNamespace != "" {
 		seen[appNamespace] = struct{}{}
 	}
+	if kop := drpc.Spec.KubeObjectProtection; kop != nil {
+		if kop.RecipeRef != nil && kop.RecipeRef.Namespace != "" {
+			seen[kop.RecipeRef.Namespace] = struct{}{}
+		}
+		for _, ns := range VeleroNamespaces {
+			seen[ns] = struct{}{}
+		}
+	}
 	return slices.Collect(maps.Keys(seen))
 }
 
@@ -184,6 +221,29 @@ func VRGNamespace(drpc *ramenapi.DRPlacementControl) string {
 	return drpc.Annotations[drpcAppNamespaceAnnotation]
 }
 
+// ProtectedNamespaces returns the namespaces on the managed clusters with resources protected by
+// the VRG. Discovered applications protect the DRPC protected namespaces, other applications
+// protect the VRG namespace.
+func ProtectedNamespaces(drpc *ramenapi.DRPlacementControl) []string {
+	if drpc.Spec.ProtectedNamespaces != nil && len(*drpc.Spec.ProtectedNamespaces) > 0 {
+		return *drpc.Spec.ProtectedNamespaces
+	}
+	return []string{VRGNamespace(drpc)}
+}
+
+// VRGManifestWorkName returns the name of the ManifestWork delivering the VRG to the managed
+// clusters.
+func VRGManifestWorkName(drpc *ramenapi.DRPlacementControl) string {
+	return fmt.Sprintf(manifestWorkNameFormat, drpc.Name, VRGNamespace(drpc), manifestWorkTypeVRG)
+}
+
+// NamespaceManifestWorkName returns the name of the ManifestWork delivering the application
+// namespace to the managed clusters.
+func NamespaceManifestWorkName(drpc *ramenapi.DRPlacementControl) string {
+	return fmt.Sprintf(manifestWorkNameFormat, drpc.Name, VRGNamespace(drpc),
+		manifestWorkTypeNamespace)
+}
+
 // ClusterTime returns the API server time when the resource was gathered,
 // from the annotation added by the gathering tool.
 func ClusterTime(annotations map[string]string) (*time.Time, error) {
@@ -208,24 +268,42 @@ func ClusterTime(annotations map[string]string) (*time.Time, error) {
 // PrimaryCluster returns the desired cluster for the application. During failover or relocate it
 // may take few minutes until the application is placed on this cluster.
 func PrimaryCluster(ctx Context, drpc *ramenapi.DRPlacementControl) (*e2etypes.Cluster, error) {
-	return ctx.Env().GetCluster(primaryClusterName(drpc))
+	return ManagedCluster(ctx, primaryClusterName(drpc))
 }
 
-// SecondaryCluster returns the desired secondary cluster for the application. During failover or
-// relocate it may take few minutes until the application is moved out of the secondary cluster.
+// SecondaryCluster returns the desired secondary cluster for the application, the peer of the
+// primary cluster in the gathered DRPC DRPolicy. During failover or relocate it may take few
+// minutes until the application is moved out of the secondary cluster.
 func SecondaryCluster(ctx Context, drpc *ramenapi.DRPlacementControl) (*e2etypes.Cluster, error) {
 	clusterName := primaryClusterName(drpc)
 
-	// TODO: Use the dr policy to match ramen behavior.
-	env := ctx.Env()
-	switch clusterName {
-	case env.C1.Name:
-		return env.GetCluster(env.C2.Name)
-	case env.C2.Name:
-		return env.GetCluster(env.C1.Name)
-	default:
+	reader := ctx.OutputReader(ctx.Env().Hub.Name)
+	drPolicy, err := ReadDRPolicy(reader, drpc.Spec.DRPolicyRef.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read drpolicy %q: %w", drpc.Spec.DRPolicyRef.Name, err)
+	}
+
+	if !slices.Contains(drPolicy.Spec.DRClusters, clusterName) {
 		return nil, fmt.Errorf("primary cluster %q unknown", clusterName)
 	}
+
+	for _, name := range drPolicy.Spec.DRClusters {
+		if name != clusterName {
+			return ManagedCluster(ctx, name)
+		}
+	}
+
+	return nil, fmt.Errorf("drpolicy %q has no peer for cluster %q", drPolicy.Name, clusterName)
+}
+
+// ManagedCluster returns the managed cluster with the given name.
+func ManagedCluster(ctx Context, name string) (*e2etypes.Cluster, error) {
+	for _, cluster := range ctx.ManagedClusters() {
+		if cluster.Name == name {
+			return cluster, nil
+		}
+	}
+	return nil, fmt.Errorf("managed cluster %q not found", name)
 }
 
 func StablePhase(action ramenapi.DRAction) (ramenapi.DRState, error) {
@@ -251,6 +329,17 @@ func GetDRPC(ctx Context, drpcName, drpcNamespace string) (*ramenapi.DRPlacement
 	return drpc, nil
 }
 
+// GetDRPolicy gets a ramen DRPolicy from the hub.
+func GetDRPolicy(ctx Context, name string) (*ramenapi.DRPolicy, error) {
+	drPolicy := &ramenapi.DRPolicy{}
+	key := types.NamespacedName{Name: name}
+	err := ctx.Env().Hub.Client.Get(ctx.Context(), key, drPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return drPolicy, nil
+}
+
 // ReadDRPC reads a ramen DRPlacementControl from the output directory.
 func ReadDRPC(
 	reader gathering.OutputReader,
@@ -325,14 +414,69 @@ func ListDRPolicies(reader gathering.OutputReader) ([]string, error) {
 	return reader.ListResources("", resource)
 }
 
+// ListMaintenanceModes lists ramen MaintenanceModes from the output directory.
+func ListMaintenanceModes(reader gathering.OutputReader) ([]string, error) {
+	resource := ramenapi.GroupVersion.Group + "/" + maintenanceModePlural
+	return reader.ListResources("", resource)
+}
+
+// ReadMaintenanceMode reads a ramen MaintenanceMode from the output directory.
+func ReadMaintenanceMode(
+	reader gathering.OutputReader,
+	name string,
+) (*ramenapi.MaintenanceMode, error) {
+	resource := ramenapi.GroupVersion.Group + "/" + maintenanceModePlural
+	data, err := reader.ReadResource("", resource, name)
+	if err != nil {
+		return nil, err
+	}
+	mm := &ramenapi.MaintenanceMode{}
+	if err := yaml.Unmarshal(data, mm); err != nil {
+		return nil, err
+	}
+	return mm, nil
+}
+
+// NetworkFenceName returns the name of the NetworkFence created by ramen to fence a DRCluster.
+func NetworkFenceName(drClusterName string) string {
+	return networkFenceNamePrefix + drClusterName
+}
+
 // ListDRClusters lists ramen DRClusters from the output directory.
 func ListDRClusters(reader gathering.OutputReader) ([]string, error) {
 	resource := ramenapi.GroupVersion.Group + "/" + drClusterPlural
 	return reader.ListResources("", resource)
 }
 
+// IsMetroDRPolicy returns true if the DRPolicy uses synchronous replication (Metro DR). Ramen uses
+// synchronous replication when the DRPolicy has no scheduling interval and all DRClusters are in
+// the same region. DRClusters that were not gathered are not checked. Returns false if no DRCluster
+// was gathered, since the DRPolicy cannot be identified as a Metro DR policy.
+func IsMetroDRPolicy(reader gathering.OutputReader, drPolicy *ramenapi.DRPolicy) (bool, error) {
+	if drPolicy.Spec.SchedulingInterval != "" {
+		return false, nil
+	}
+
+	var regions []ramenapi.Region
+	for _, name := range drPolicy.Spec.DRClusters {
+		drCluster, err := ReadDRCluster(reader, name)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return false, fmt.Errorf("failed to read drcluster %q: %w", name, err)
+		}
+		if !slices.Contains(regions, drCluster.Spec.Region) {
+			regions = append(regions, drCluster.Spec.Region)
+		}
+	}
+
+	return len(regions) == 1, nil
+}
+
 // ApplicationProfiles returns the S3 store profiles relevant for an application,
-// filtered to only those referenced by the primary VRG's spec.s3Profiles.
+// filtered to only those referenced by the primary VRG's spec.s3Profiles. If the primary VRG
+// was not gathered, the secondary VRG's spec.s3Profiles is used.
 func ApplicationProfiles(
 	ctx Context,
 	drpcName, drpcNamespace string,
@@ -356,11 +500,20 @@ func ApplicationProfiles(
 			drpc.Namespace, drpc.Name, drpcAppNamespaceAnnotation)
 	}
 
-	primaryReader := ctx.OutputReader(primary.Name)
-	vrg, err := ReadVRG(primaryReader, drpc.Name, vrgNamespace)
+	vrgCluster := primary
+	vrg, err := ReadVRG(ctx.OutputReader(vrgCluster.Name), drpc.Name, vrgNamespace)
+	if errors.Is(err, os.ErrNotExist) {
+		// The primary cluster data is missing when failing over from a failed primary cluster,
+		// but the secondary VRG uses the same S3 profiles.
+		vrgCluster, err = SecondaryCluster(ctx, drpc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find secondary cluster: %w", err)
+		}
+		vrg, err = ReadVRG(ctx.OutputReader(vrgCluster.Name), drpc.Name, vrgNamespace)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to read vrg \"%s/%s\" from cluster %q: %w",
-			vrgNamespace, drpc.Name, primary.Name, err)
+			vrgNamespace, drpc.Name, vrgCluster.Name, err)
 	}
 
 	appProfiles := vrg.Spec.S3Profiles
@@ -369,10 +522,11 @@ func ApplicationProfiles(
 			vrgNamespace, drpc.Name)
 	}
 
+	hubOperator := Operator(ctx.Config(), ctx.Env().Hub.Name, ramenapi.DRHubType)
 	allProfiles, err := ClusterProfiles(
 		hubReader,
-		HubOperatorConfigMapName,
-		ctx.Config().Namespaces.RamenHubNamespace,
+		hubOperator.ConfigMap,
+		hubOperator.Namespace,
 	)
 	if err != nil {
 		return nil, err
@@ -456,6 +610,22 @@ func ApplicationS3Prefix(
 	return fmt.Sprintf("%s/%s/", vrgNamespace, drpc.Name), nil
 }
 
+// ReadOperatorConfig reads the ramen config of the operator from the output directory.
+func ReadOperatorConfig(
+	reader gathering.OutputReader,
+	operator config.Operator,
+) (*ramenapi.RamenConfig, error) {
+	return getRamenConfigMapData(reader, operator.ConfigMap, operator.Namespace)
+}
+
+// VeleroNamespace returns the velero namespace used by ramen.
+func VeleroNamespace(ramenConfig *ramenapi.RamenConfig) string {
+	if ramenConfig.KubeObjectProtection.VeleroNamespaceName != "" {
+		return ramenConfig.KubeObjectProtection.VeleroNamespaceName
+	}
+	return VeleroNamespaceDefault
+}
+
 // ParseRamenConfig parses the ramen config from a ramen operator configmap.
 func ParseRamenConfig(configMap *corev1.ConfigMap) (*ramenapi.RamenConfig, error) {
 	config := &ramenapi.RamenConfig{}
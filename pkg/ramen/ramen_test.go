@@ -14,7 +14,6 @@ import (
 
 	"github.com/nirs/kubectl-gather/pkg/gather"
 	"github.com/ramendr/ramen/api/v1alpha1"
-	e2econfig "github.com/ramendr/ramen/e2e/config"
 	e2etypes "github.com/ramendr/ramen/e2e/types"
 	corev1 "k8s.io/api/core/v1"
 	v1meta "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,7 +26,7 @@ import (
 
 var (
 	testConfig = &config.Config{
-		Namespaces: e2econfig.K8sNamespaces,
+		Namespaces: config.K8sNamespaces,
 	}
 )
 
@@ -35,10 +34,12 @@ const (
 	disappName               = "disapp-deploy-rbd"
 	disappProtectedNamespace = "e2e-disapp-deploy-rbd"
 
-	testHubName     = "hub"
-	testPrimaryName = "dr1"
-	testDRPCName    = "my-app"
-	testNamespace   = "my-app-ns"
+	testHubName       = "hub"
+	testPrimaryName   = "dr1"
+	testSecondaryName = "dr2"
+	testDRPCName      = "my-app"
+	testNamespace     = "my-app-ns"
+	testDRPolicyName  = "dr-policy"
 )
 
 // testContext implements ramen.Context for testing ApplicationProfiles.
@@ -46,12 +47,19 @@ type testContext struct {
 	env     *e2etypes.Env
 	config  *config.Config
 	dataDir string
+
+	// managedClusters are additional managed clusters.
+	managedClusters []*e2etypes.Cluster
 }
 
 func (c *testContext) Env() *e2etypes.Env {
 	return c.env
 }
 
+func (c *testContext) ManagedClusters() []*e2etypes.Cluster {
+	return append(c.env.ManagedClusters(), c.managedClusters...)
+}
+
 func (c *testContext) Context() context.Context {
 	return context.Background()
 }
@@ -147,6 +155,35 @@ func TestApplicationNamespacesDuplicateProtectedNamespaces(t *testing.T) {
 
 }
 
+func TestApplicationNamespacesKubeObjectProtection(t *testing.T) {
+	drpc := &v1alpha1.DRPlacementControl{
+		ObjectMeta: v1meta.ObjectMeta{
+			Name:      disappName,
+			Namespace: testConfig.Namespaces.RamenOpsNamespace,
+			Annotations: map[string]string{
+				drpcAppNamespaceAnnotation: testConfig.Namespaces.RamenOpsNamespace,
+			},
+		},
+		Spec: v1alpha1.DRPlacementControlSpec{
+			ProtectedNamespaces: &[]string{disappProtectedNamespace},
+			KubeObjectProtection: &v1alpha1.KubeObjectProtectionSpec{
+				RecipeRef: &v1alpha1.RecipeRef{
+					Namespace: "recipe-namespace",
+					Name:      "recipe-name",
+				},
+			},
+		},
+	}
+
+	namespaces := ApplicationNamespaces(drpc)
+	expectedNamespaces := sets.Sorted(append([]string{
+		testConfig.Namespaces.RamenOpsNamespace,
+		disappProtectedNamespace,
+		"recipe-namespace",
+	}, VeleroNamespaces...))
+	checkNamespaces(t, namespaces, expectedNamespaces)
+}
+
 func TestApplicationNamespacesMissingAppNamespaceAnnotation(t *testing.T) {
 	drpc := &v1alpha1.DRPlacementControl{
 		ObjectMeta: v1meta.ObjectMeta{
@@ -270,7 +307,7 @@ func TestApplicationProfiles(t *testing.T) {
 			ctx := newTestContext(t)
 			writeConfigMap(t, ctx, tt.hubProfiles)
 			writeDRPC(t, ctx)
-			writeVRG(t, ctx, tt.vrgProfiles)
+			writeVRG(t, ctx, testPrimaryName, tt.vrgProfiles)
 
 			profiles, err := ApplicationProfiles(ctx, testDRPCName, testNamespace)
 			if err != nil {
@@ -306,7 +343,7 @@ func TestApplicationProfilesErrors(t *testing.T) {
 			ctx := newTestContext(t)
 			writeConfigMap(t, ctx, tt.hubProfiles)
 			writeDRPC(t, ctx)
-			writeVRG(t, ctx, tt.vrgProfiles)
+			writeVRG(t, ctx, testPrimaryName, tt.vrgProfiles)
 
 			profiles, err := ApplicationProfiles(ctx, testDRPCName, testNamespace)
 			if err == nil {
@@ -319,6 +356,159 @@ func TestApplicationProfilesErrors(t *testing.T) {
 	}
 }
 
+func TestApplicationProfilesPrimaryVRGMissing(t *testing.T) {
+	ctx := newTestContext(t)
+	writeConfigMap(t, ctx, []string{"profile-1", "profile-2"})
+	writeDRPolicy(t, ctx, []string{testPrimaryName, testSecondaryName})
+	writeDRPC(t, ctx)
+	writeVRG(t, ctx, testSecondaryName, []string{"profile-2"})
+
+	profiles, err := ApplicationProfiles(ctx, testDRPCName, testNamespace)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(profiles) != 1 || profiles[0].S3ProfileName != "profile-2" {
+		t.Errorf("expected profile %q, got %v", "profile-2", profiles)
+	}
+}
+
+func TestSecondaryCluster(t *testing.T) {
+	tests := []struct {
+		name       string
+		drClusters []string
+		drpcSpec   v1alpha1.DRPlacementControlSpec
+		expected   string
+	}{
+		{
+			name:       "deployed",
+			drClusters: []string{testPrimaryName, testSecondaryName},
+			drpcSpec:   v1alpha1.DRPlacementControlSpec{PreferredCluster: testPrimaryName},
+			expected:   testSecondaryName,
+		},
+		{
+			name:       "relocated",
+			drClusters: []string{testPrimaryName, testSecondaryName},
+			drpcSpec: v1alpha1.DRPlacementControlSpec{
+				PreferredCluster: testSecondaryName,
+				Action:           v1alpha1.ActionRelocate,
+			},
+			expected: testPrimaryName,
+		},
+		{
+			name:       "failed over",
+			drClusters: []string{testPrimaryName, testSecondaryName},
+			drpcSpec: v1alpha1.DRPlacementControlSpec{
+				PreferredCluster: testPrimaryName,
+				FailoverCluster:  testSecondaryName,
+				Action:           v1alpha1.ActionFailover,
+			},
+			expected: testPrimaryName,
+		},
+		{
+			name:       "third managed cluster",
+			drClusters: []string{testPrimaryName, "dr3"},
+			drpcSpec:   v1alpha1.DRPlacementControlSpec{PreferredCluster: testPrimaryName},
+			expected:   "dr3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestContext(t)
+			ctx.managedClusters = []*e2etypes.Cluster{{Name: "dr3"}}
+			writeDRPolicy(t, ctx, tt.drClusters)
+			drpc := testSecondaryDRPC(tt.drpcSpec)
+
+			cluster, err := SecondaryCluster(ctx, drpc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cluster.Name != tt.expected {
+				t.Errorf("expected secondary cluster %q, got %q", tt.expected, cluster.Name)
+			}
+		})
+	}
+}
+
+func TestSecondaryClusterErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		drClusters []string
+	}{
+		{
+			name:       "no drpolicy",
+			drClusters: nil,
+		},
+		{
+			name:       "primary cluster not in drpolicy",
+			drClusters: []string{testSecondaryName, "dr3"},
+		},
+		{
+			name:       "secondary cluster not configured",
+			drClusters: []string{testPrimaryName, "dr3"},
+		},
+		{
+			name:       "no peer cluster",
+			drClusters: []string{testPrimaryName},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestContext(t)
+			if tt.drClusters != nil {
+				writeDRPolicy(t, ctx, tt.drClusters)
+			}
+			drpc := testSecondaryDRPC(
+				v1alpha1.DRPlacementControlSpec{PreferredCluster: testPrimaryName},
+			)
+
+			cluster, err := SecondaryCluster(ctx, drpc)
+			if err == nil {
+				t.Fatalf("expected error, got cluster %q", cluster.Name)
+			}
+		})
+	}
+}
+
+func TestIsMetroDRPolicy(t *testing.T) {
+	drClusters := []string{testPrimaryName, testSecondaryName}
+	tests := []struct {
+		name               string
+		schedulingInterval string
+		regions            []v1alpha1.Region
+		expected           bool
+	}{
+		{name: "same region", regions: []v1alpha1.Region{"east", "east"}, expected: true},
+		{name: "different regions", regions: []v1alpha1.Region{"east", "west"}},
+		{name: "scheduling interval", schedulingInterval: "5m",
+			regions: []v1alpha1.Region{"east", "east"}},
+		{name: "one drcluster gathered", regions: []v1alpha1.Region{"east"}, expected: true},
+		{name: "no drcluster gathered"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestContext(t)
+			for i, region := range tt.regions {
+				writeDRCluster(t, ctx, drClusters[i], region)
+			}
+			drPolicy := &v1alpha1.DRPolicy{
+				Spec: v1alpha1.DRPolicySpec{
+					DRClusters:         drClusters,
+					SchedulingInterval: tt.schedulingInterval,
+				},
+			}
+			metro, err := IsMetroDRPolicy(ctx.OutputReader(testHubName), drPolicy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if metro != tt.expected {
+				t.Fatalf("expected metro %v, got %v", tt.expected, metro)
+			}
+		})
+	}
+}
+
 func checkNamespaces(t *testing.T, namespaces []string, expected []string) {
 	slices.Sort(namespaces)
 	if !slices.Equal(namespaces, expected) {
@@ -333,13 +523,24 @@ func newTestContext(t *testing.T) *testContext {
 		env: &e2etypes.Env{
 			Hub: &e2etypes.Cluster{Name: testHubName},
 			C1:  &e2etypes.Cluster{Name: testPrimaryName},
-			C2:  &e2etypes.Cluster{Name: "dr2"},
+			C2:  &e2etypes.Cluster{Name: testSecondaryName},
 		},
 		config:  testConfig,
 		dataDir: dataDir,
 	}
 }
 
+func testSecondaryDRPC(spec v1alpha1.DRPlacementControlSpec) *v1alpha1.DRPlacementControl {
+	spec.DRPolicyRef = corev1.ObjectReference{Name: testDRPolicyName}
+	return &v1alpha1.DRPlacementControl{
+		ObjectMeta: v1meta.ObjectMeta{
+			Name:      testDRPCName,
+			Namespace: testNamespace,
+		},
+		Spec: spec,
+	}
+}
+
 func writeConfigMap(t *testing.T, ctx *testContext, profileNames []string) {
 	t.Helper()
 	ramenConfig := &v1alpha1.RamenConfig{
@@ -377,13 +578,40 @@ func writeDRPC(t *testing.T, ctx *testContext) {
 			},
 		},
 		Spec: v1alpha1.DRPlacementControlSpec{
+			DRPolicyRef:      corev1.ObjectReference{Name: testDRPolicyName},
 			PreferredCluster: testPrimaryName,
 		},
 	}
 	writeResource(t, filepath.Join(ctx.dataDir, testHubName), drpc)
 }
 
-func writeVRG(t *testing.T, ctx *testContext, s3Profiles []string) {
+func writeDRPolicy(t *testing.T, ctx *testContext, drClusters []string) {
+	t.Helper()
+	drPolicy := &v1alpha1.DRPolicy{
+		ObjectMeta: v1meta.ObjectMeta{
+			Name: testDRPolicyName,
+		},
+		Spec: v1alpha1.DRPolicySpec{
+			DRClusters: drClusters,
+		},
+	}
+	writeResource(t, filepath.Join(ctx.dataDir, testHubName), drPolicy)
+}
+
+func writeDRCluster(t *testing.T, ctx *testContext, name string, region v1alpha1.Region) {
+	t.Helper()
+	drCluster := &v1alpha1.DRCluster{
+		ObjectMeta: v1meta.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.DRClusterSpec{
+			Region: region,
+		},
+	}
+	writeResource(t, filepath.Join(ctx.dataDir, testHubName), drCluster)
+}
+
+func writeVRG(t *testing.T, ctx *testContext, cluster string, s3Profiles []string) {
 	t.Helper()
 	vrg := &v1alpha1.VolumeReplicationGroup{
 		ObjectMeta: v1meta.ObjectMeta{
@@ -394,7 +622,7 @@ func writeVRG(t *testing.T, ctx *testContext, s3Profiles []string) {
 			S3Profiles: s3Profiles,
 		},
 	}
-	writeResource(t, filepath.Join(ctx.dataDir, testPrimaryName), vrg)
+	writeResource(t, filepath.Join(ctx.dataDir, cluster), vrg)
 }
 
 func writeResource(t *testing.T, clusterDir string, obj v1meta.Object) {
@@ -408,6 +636,10 @@ func writeResource(t *testing.T, clusterDir string, obj v1meta.Object) {
 		resource = v1alpha1.GroupVersion.Group + "/" + drpcPlural
 	case *v1alpha1.VolumeReplicationGroup:
 		resource = v1alpha1.GroupVersion.Group + "/" + vrgPlural
+	case *v1alpha1.DRPolicy:
+		resource = v1alpha1.GroupVersion.Group + "/" + drPolicyPlural
+	case *v1alpha1.DRCluster:
+		resource = v1alpha1.GroupVersion.Group + "/" + drClusterPlural
 	default:
 		t.Fatalf("unsupported resource type: %T", obj)
 	}
@@ -416,7 +648,10 @@ func writeResource(t *testing.T, clusterDir string, obj v1meta.Object) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	dir := filepath.Join(clusterDir, "namespaces", obj.GetNamespace(), resource)
+	dir := filepath.Join(clusterDir, "cluster", resource)
+	if obj.GetNamespace() != "" {
+		dir = filepath.Join(clusterDir, "namespaces", obj.GetNamespace(), resource)
+	}
 	if err := os.MkdirAll(dir, 0o700); err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package recipe
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+)
+
+const (
+	// Resources selected by recipe hooks.
+	SelectResourcePod         = "pod"
+	SelectResourceDeployment  = "deployment"
+	SelectResourceStatefulSet = "statefulset"
+
+	// We don't depend on the recipe apis, the resources are parsed to Recipe.
+	recipeResource = "ramendr.openshift.io/recipes"
+)
+
+// ErrInvalidRecipe is returned when a recipe cannot be parsed.
+var ErrInvalidRecipe = errors.New("invalid recipe")
+
+// Recipe is the part of the recipe used to validate kube objects protection.
+type Recipe struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              Spec `json:"spec"`
+}
+
+// Spec is the spec of a recipe.
+type Spec struct {
+	Hooks []Hook `json:"hooks,omitempty"`
+}
+
+// Hook is a recipe hook running operations or checks on selected resources.
+type Hook struct {
+	Name           string                `json:"name"`
+	Namespace      string                `json:"namespace"`
+	SelectResource string                `json:"selectResource,omitempty"`
+	LabelSelector  *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	NameSelector   string                `json:"nameSelector,omitempty"`
+}
+
+// ReadRecipe reads a recipe from the output directory. Returns an error wrapping ErrInvalidRecipe
+// if the recipe cannot be parsed.
+func ReadRecipe(reader gathering.OutputReader, name, namespace string) (*Recipe, error) {
+	data, err := reader.ReadResource(namespace, recipeResource, name)
+	if err != nil {
+		return nil, err
+	}
+	recipe := &Recipe{}
+	if err := yaml.Unmarshal(data, recipe); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRecipe, err)
+	}
+	return recipe, nil
+}
+
+// ExpandParameters expands recipe parameters ($name or ${name}) in value. Parameters with multiple
+// values are joined with commas.
+func ExpandParameters(value string, parameters map[string][]string) string {
+	return os.Expand(value, func(name string) string {
+		return strings.Join(parameters[name], ",")
+	})
+}
+
+// HookResources returns the names of the resources in namespace selected by the hook.
+func HookResources(reader gathering.OutputReader, hook *Hook, namespace string) ([]string, error) {
+	resource, err := hookResource(hook)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err := reader.ListResources(namespace, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var nameRegexp *regexp.Regexp
+	if hook.NameSelector != "" {
+		nameRegexp, err = regexp.Compile("^" + hook.NameSelector + "$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid hook name selector %q: %w", hook.NameSelector, err)
+		}
+	}
+
+	var selector labels.Selector
+	if hook.LabelSelector != nil {
+		selector, err = metav1.LabelSelectorAsSelector(hook.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hook label selector: %w", err)
+		}
+	}
+
+	var selected []string
+	for _, name := range names {
+		if nameRegexp != nil && !nameRegexp.MatchString(name) {
+			continue
+		}
+		if selector != nil {
+			data, err := reader.ReadResource(namespace, resource, name)
+			if err != nil {
+				return nil, err
+			}
+			obj := &metav1.PartialObjectMetadata{}
+			if err := yaml.Unmarshal(data, obj); err != nil {
+				return nil, err
+			}
+			if !selector.Matches(labels.Set(obj.Labels)) {
+				continue
+			}
+		}
+		selected = append(selected, name)
+	}
+
+	return selected, nil
+}
+
+func hookResource(hook *Hook) (string, error) {
+	switch hook.SelectResource {
+	case "", SelectResourcePod:
+		return "pods", nil
+	case SelectResourceDeployment:
+		return appsv1.GroupName + "/deployments", nil
+	case SelectResourceStatefulSet:
+		return appsv1.GroupName + "/statefulsets", nil
+	default:
+		return "", fmt.Errorf("unknown hook resource %q", hook.SelectResource)
+	}
+}
@@ -25,10 +25,47 @@ type ProtectedPVCSummary struct {
 	Deleted     ValidatedBool          `json:"deleted"`
 	Phase       ValidatedString        `json:"phase"`
 	Conditions  ValidatedConditionList `json:"conditions,omitempty"`
+	// ReplicationResources are the resources replicating the PVC on the managed clusters.
+	ReplicationResources []ReplicationResourceSummary `json:"replicationResources,omitempty"`
 }
 
 func (p *ProtectedPVCSummary) AggregateState() ValidationState {
-	return aggregateState(&p.Phase, &p.Deleted, p.Conditions)
+	state := aggregateState(&p.Phase, &p.Deleted, p.Conditions)
+	for i := range p.ReplicationResources {
+		state = significantState(state, p.ReplicationResources[i].AggregateState())
+	}
+	return state
+}
+
+// ReplicationResourceSummary is the summary of a resource replicating a protected PVC on a managed
+// cluster. Volrep uses a VolumeReplication or VolumeGroupReplication on both clusters. Volsync uses
+// a ReplicationSource on the primary cluster and a ReplicationDestination on the secondary cluster.
+type ReplicationResourceSummary struct {
+	Kind    string        `json:"kind"`
+	Name    string        `json:"name"`
+	Cluster string        `json:"cluster"`
+	Deleted ValidatedBool `json:"deleted"`
+	// State is the volrep replication state.
+	State *ValidatedString `json:"state,omitempty"`
+	// LastSyncTime, LastSyncDuration, and VolumeSnapshotClass are reported for volsync.
+	LastSyncTime        *ValidatedTime         `json:"lastSyncTime,omitempty"`
+	LastSyncDuration    string                 `json:"lastSyncDuration,omitempty"`
+	VolumeSnapshotClass *ValidatedString       `json:"volumeSnapshotClass,omitempty"`
+	Conditions          ValidatedConditionList `json:"conditions,omitempty"`
+}
+
+func (r *ReplicationResourceSummary) AggregateState() ValidationState {
+	items := []StateAggregator{&r.Deleted, r.Conditions}
+	if r.State != nil {
+		items = append(items, r.State)
+	}
+	if r.LastSyncTime != nil {
+		items = append(items, r.LastSyncTime)
+	}
+	if r.VolumeSnapshotClass != nil {
+		items = append(items, r.VolumeSnapshotClass)
+	}
+	return aggregateState(items...)
 }
 
 // ProtectedPVCList is a list of protected PVC summaries.
@@ -42,6 +79,16 @@ func (p ProtectedPVCList) AggregateState() ValidationState {
 	return state
 }
 
+// PVCSummary is the summary of a PVC in the application namespaces.
+type PVCSummary struct {
+	Name         string          `json:"name"`
+	Namespace    string          `json:"namespace"`
+	StorageClass string          `json:"storageClass,omitempty"`
+	Replication  ReplicationType `json:"replication,omitempty"`
+	// Protected value is true if the PVC is protected by the VRG.
+	Protected ValidatedBool `json:"protected"`
+}
+
 // PVCGroupsSummary represents list of CGs that are protected by the VRG.
 type PVCGroupsSummary struct {
 	Grouped []string `json:"grouped,omitempty"`
@@ -54,14 +101,28 @@ type DRPCSummary struct {
 	ClusterTime        *time.Time             `json:"clusterTime,omitempty"`
 	Deleted            ValidatedBool          `json:"deleted"`
 	DRPolicy           string                 `json:"drPolicy"`
+	Mode               DRMode                 `json:"mode,omitempty"`
 	SchedulingInterval ValidatedDuration      `json:"schedulingInterval"`
 	LastGroupSyncTime  ValidatedTime          `json:"lastGroupSyncTime"`
 	Action             ValidatedString        `json:"action"`
 	Phase              ValidatedString        `json:"phase"`
 	Progression        ValidatedString        `json:"progression"`
+	Progress           *DRPCProgressSummary   `json:"progress,omitempty"`
 	Conditions         ValidatedConditionList `json:"conditions,omitempty"`
 }
 
+// DRPCProgressSummary reports how long a DRPC has been in the current phase and progression. It is
+// reported only when the DRPC progression is not stable.
+type DRPCProgressSummary struct {
+	// ActionStartTime is the time the current action started.
+	ActionStartTime *time.Time `json:"actionStartTime,omitempty"`
+	// PhaseElapsed is the time since the current action started.
+	PhaseElapsed string `json:"phaseElapsed,omitempty"`
+	// ProgressionElapsed value is the time since the last change in the DRPC status, validated
+	// against the stuck progression timeout.
+	ProgressionElapsed ValidatedDuration `json:"progressionElapsed"`
+}
+
 // VRGSummary is the summary of a VRG.
 type VRGSummary struct {
 	Name               string                 `json:"name"`
@@ -76,21 +137,96 @@ type VRGSummary struct {
 	PVCGroups          []PVCGroupsSummary     `json:"pvcGroups,omitempty"`
 }
 
+// PlacementSummary is the summary of the OCM Placement of the application.
+type PlacementSummary struct {
+	Name      string        `json:"name"`
+	Namespace string        `json:"namespace"`
+	Deleted   ValidatedBool `json:"deleted"`
+	// Decision value is the cluster selected by the placement decisions.
+	Decision ValidatedString `json:"decision"`
+}
+
+// ManifestWorkSummary is the summary of an OCM ManifestWork delivering application resources to a
+// managed cluster.
+type ManifestWorkSummary struct {
+	Name       string                 `json:"name"`
+	Deleted    ValidatedBool          `json:"deleted"`
+	Conditions ValidatedConditionList `json:"conditions,omitempty"`
+}
+
+// VRGRoleSummary is the role of the application VRG on a managed cluster.
+type VRGRoleSummary struct {
+	Cluster string `json:"cluster"`
+	// ReplicationState is the desired VRG replication state (spec.replicationState).
+	ReplicationState string `json:"replicationState"`
+	// State is the current VRG state (status.state).
+	State string `json:"state,omitempty"`
+}
+
+// KubeObjectProtectionSummary is the summary of the application kube objects protection.
+type KubeObjectProtectionSummary struct {
+	CaptureInterval string `json:"captureInterval"`
+	// LastCaptureTime is the start time of the kube objects capture to recover from on the
+	// primary cluster.
+	LastCaptureTime ValidatedTime               `json:"lastCaptureTime"`
+	Recipe          *RecipeSummary              `json:"recipe,omitempty"`
+	Tooling         []KubeObjectsToolingSummary `json:"tooling,omitempty"`
+}
+
+// RecipeSummary is the summary of the recipe referenced by the DRPC on the primary cluster.
+type RecipeSummary struct {
+	Name      string        `json:"name"`
+	Namespace string        `json:"namespace"`
+	Deleted   ValidatedBool `json:"deleted"`
+	// Valid value is true if the recipe was parsed successfully.
+	Valid *ValidatedBool      `json:"valid,omitempty"`
+	Hooks []RecipeHookSummary `json:"hooks,omitempty"`
+}
+
+// RecipeHookSummary is the summary of a recipe hook.
+type RecipeHookSummary struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// Resources value is the names of the resources selected by the hook.
+	Resources ValidatedString `json:"resources"`
+}
+
+// KubeObjectsToolingSummary is the summary of the backup and restore tooling used by ramen to
+// protect kube objects on a managed cluster.
+type KubeObjectsToolingSummary struct {
+	Cluster string `json:"cluster"`
+	// Velero value is the namespace of the velero deployment.
+	Velero ValidatedString `json:"velero"`
+}
+
+// ApplicationReadiness is the verdict of the application readiness for a DR action. Blocking
+// reasons prevent the action, non-blocking reasons should be reviewed before starting the action.
+type ApplicationReadiness struct {
+	Action      string   `json:"action"`
+	Ready       bool     `json:"ready"`
+	Blocking    []string `json:"blocking,omitempty"`
+	NonBlocking []string `json:"nonBlocking,omitempty"`
+}
+
 // ApplicationHubStaus is the application status on the hub.
 type ApplicationStatusHub struct {
-	DRPC DRPCSummary `json:"drpc"`
+	DRPC      DRPCSummary       `json:"drpc"`
+	Placement *PlacementSummary `json:"placement,omitempty"`
 }
 
 // ApplicationHubStaus is the application status on a managed cluster.
 type ApplicationStatusCluster struct {
-	Name string     `json:"name"`
-	VRG  VRGSummary `json:"vrg"`
+	Name          string                `json:"name"`
+	VRG           VRGSummary            `json:"vrg"`
+	ManifestWorks []ManifestWorkSummary `json:"manifestWorks,omitempty"`
+	PVCs          []PVCSummary          `json:"pvcs,omitempty"`
 }
 
 // ApplicationS3ProfileStatus is the status of an S3 profile.
 type ApplicationS3ProfileStatus struct {
-	Name     string        `json:"name"`
-	Gathered ValidatedBool `json:"gathered"`
+	Name      string              `json:"name"`
+	Gathered  ValidatedBool       `json:"gathered"`
+	Transport *S3TransportSummary `json:"transport,omitempty"`
 }
 
 // ApplicationS3Status is the status of all S3 profiles.
@@ -104,6 +240,9 @@ type ApplicationStatus struct {
 	PrimaryCluster   ApplicationStatusCluster `json:"primaryCluster"`
 	SecondaryCluster ApplicationStatusCluster `json:"secondaryCluster"`
 	S3               ApplicationS3Status      `json:"s3"`
+	VRGRoles         *ValidatedVRGRolesList   `json:"vrgRoles,omitempty"`
+	// KubeObjectProtection is reported only if the DRPC enables kube objects protection.
+	KubeObjectProtection *KubeObjectProtectionSummary `json:"kubeObjectProtection,omitempty"`
 }
 
 func (a *ApplicationStatus) Equal(o *ApplicationStatus) bool {
@@ -125,6 +264,92 @@ func (a *ApplicationStatus) Equal(o *ApplicationStatus) bool {
 	if !a.S3.Equal(&o.S3) {
 		return false
 	}
+	if !a.VRGRoles.Equal(o.VRGRoles) {
+		return false
+	}
+	if !a.KubeObjectProtection.Equal(o.KubeObjectProtection) {
+		return false
+	}
+	return true
+}
+
+// State returns the validation state of the verdict for displaying the verdict.
+func (r *ApplicationReadiness) State() ValidationState {
+	if r.Ready {
+		return OK
+	}
+	return Problem
+}
+
+func (r *ApplicationReadiness) Equal(o *ApplicationReadiness) bool {
+	if r == o {
+		return true
+	}
+	if r == nil || o == nil {
+		return false
+	}
+	if r.Action != o.Action {
+		return false
+	}
+	if r.Ready != o.Ready {
+		return false
+	}
+	if !slices.Equal(r.Blocking, o.Blocking) {
+		return false
+	}
+	if !slices.Equal(r.NonBlocking, o.NonBlocking) {
+		return false
+	}
+	return true
+}
+
+func (k *KubeObjectProtectionSummary) Equal(o *KubeObjectProtectionSummary) bool {
+	if k == o {
+		return true
+	}
+	if k == nil || o == nil {
+		return false
+	}
+	if k.CaptureInterval != o.CaptureInterval {
+		return false
+	}
+	if !k.LastCaptureTime.Equal(&o.LastCaptureTime) {
+		return false
+	}
+	if !k.Recipe.Equal(o.Recipe) {
+		return false
+	}
+	if !slices.Equal(k.Tooling, o.Tooling) {
+		return false
+	}
+	return true
+}
+
+func (r *RecipeSummary) Equal(o *RecipeSummary) bool {
+	if r == o {
+		return true
+	}
+	if r == nil || o == nil {
+		return false
+	}
+	if r.Name != o.Name {
+		return false
+	}
+	if r.Namespace != o.Namespace {
+		return false
+	}
+	if r.Deleted != o.Deleted {
+		return false
+	}
+	if (r.Valid == nil) != (o.Valid == nil) {
+		return false
+	}
+	if r.Valid != nil && *r.Valid != *o.Valid {
+		return false
+	}
+	if !slices.Equal(r.Hooks, o.Hooks) {
+		return false
+	}
 	return true
 }
 
@@ -138,6 +363,38 @@ func (h *ApplicationStatusHub) Equal(o *ApplicationStatusHub) bool {
 	if !h.DRPC.Equal(&o.DRPC) {
 		return false
 	}
+	if !h.Placement.Equal(o.Placement) {
+		return false
+	}
+	return true
+}
+
+func (p *PlacementSummary) Equal(o *PlacementSummary) bool {
+	if p == o {
+		return true
+	}
+	if p == nil || o == nil {
+		return false
+	}
+	return *p == *o
+}
+
+func (m *ManifestWorkSummary) Equal(o *ManifestWorkSummary) bool {
+	if m == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if m.Name != o.Name {
+		return false
+	}
+	if m.Deleted != o.Deleted {
+		return false
+	}
+	if !slices.Equal(m.Conditions, o.Conditions) {
+		return false
+	}
 	return true
 }
 
@@ -154,6 +411,18 @@ func (c *ApplicationStatusCluster) Equal(o *ApplicationStatusCluster) bool {
 	if !c.VRG.Equal(&o.VRG) {
 		return false
 	}
+	if !slices.EqualFunc(
+		c.ManifestWorks,
+		o.ManifestWorks,
+		func(a ManifestWorkSummary, b ManifestWorkSummary) bool {
+			return a.Equal(&b)
+		},
+	) {
+		return false
+	}
+	if !slices.Equal(c.PVCs, o.PVCs) {
+		return false
+	}
 	return true
 }
 
@@ -183,6 +452,9 @@ func (d *DRPCSummary) Equal(o *DRPCSummary) bool {
 	if d.DRPolicy != o.DRPolicy {
 		return false
 	}
+	if d.Mode != o.Mode {
+		return false
+	}
 	if d.SchedulingInterval != o.SchedulingInterval {
 		return false
 	}
@@ -198,12 +470,38 @@ func (d *DRPCSummary) Equal(o *DRPCSummary) bool {
 	if d.Progression != o.Progression {
 		return false
 	}
+	if !d.Progress.Equal(o.Progress) {
+		return false
+	}
 	if !slices.Equal(d.Conditions, o.Conditions) {
 		return false
 	}
 	return true
 }
 
+func (p *DRPCProgressSummary) Equal(o *DRPCProgressSummary) bool {
+	if p == o {
+		return true
+	}
+	if p == nil || o == nil {
+		return false
+	}
+	if p.ActionStartTime != nil && o.ActionStartTime != nil {
+		if !p.ActionStartTime.Equal(*o.ActionStartTime) {
+			return false
+		}
+	} else if p.ActionStartTime != o.ActionStartTime {
+		return false
+	}
+	if p.PhaseElapsed != o.PhaseElapsed {
+		return false
+	}
+	if p.ProgressionElapsed != o.ProgressionElapsed {
+		return false
+	}
+	return true
+}
+
 func (v *VRGSummary) Equal(o *VRGSummary) bool {
 	if v == o {
 		return true
@@ -285,6 +583,62 @@ func (p *ProtectedPVCSummary) Equal(o *ProtectedPVCSummary) bool {
 	if !slices.Equal(p.Conditions, o.Conditions) {
 		return false
 	}
+	if !slices.EqualFunc(
+		p.ReplicationResources,
+		o.ReplicationResources,
+		func(a ReplicationResourceSummary, b ReplicationResourceSummary) bool {
+			return a.Equal(&b)
+		},
+	) {
+		return false
+	}
+	return true
+}
+
+func (r *ReplicationResourceSummary) Equal(o *ReplicationResourceSummary) bool {
+	if r == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if r.Kind != o.Kind {
+		return false
+	}
+	if r.Name != o.Name {
+		return false
+	}
+	if r.Cluster != o.Cluster {
+		return false
+	}
+	if r.Deleted != o.Deleted {
+		return false
+	}
+	if (r.State == nil) != (o.State == nil) {
+		return false
+	}
+	if r.State != nil && *r.State != *o.State {
+		return false
+	}
+	if r.LastSyncTime != nil && o.LastSyncTime != nil {
+		if !r.LastSyncTime.Equal(o.LastSyncTime) {
+			return false
+		}
+	} else if r.LastSyncTime != o.LastSyncTime {
+		return false
+	}
+	if r.LastSyncDuration != o.LastSyncDuration {
+		return false
+	}
+	if (r.VolumeSnapshotClass == nil) != (o.VolumeSnapshotClass == nil) {
+		return false
+	}
+	if r.VolumeSnapshotClass != nil && *r.VolumeSnapshotClass != *o.VolumeSnapshotClass {
+		return false
+	}
+	if !slices.Equal(r.Conditions, o.Conditions) {
+		return false
+	}
 	return true
 }
 
@@ -314,6 +668,9 @@ func (s *ApplicationS3ProfileStatus) Equal(o *ApplicationS3ProfileStatus) bool {
 	if s.Gathered != o.Gathered {
 		return false
 	}
+	if !s.Transport.Equal(o.Transport) {
+		return false
+	}
 	return true
 }
 
@@ -78,6 +78,11 @@ func TestReportApplicationStatusNotEqual(t *testing.T) {
 		a2.Hub.DRPC.DRPolicy = helpers.Modified
 		checkApplicationsNotEqual(t, a1, a2)
 	})
+	t.Run("hub drpc mode", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.Hub.DRPC.Mode = report.MetroDR
+		checkApplicationsNotEqual(t, a1, a2)
+	})
 	t.Run("hub drpc schedulingInterval", func(t *testing.T) {
 		a2 := testApplicationStatus()
 		a2.Hub.DRPC.SchedulingInterval = report.ValidatedDuration{
@@ -100,6 +105,19 @@ func TestReportApplicationStatusNotEqual(t *testing.T) {
 		}
 		checkApplicationsNotEqual(t, a1, a2)
 	})
+	t.Run("hub drpc progress", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.Hub.DRPC.Progress = &report.DRPCProgressSummary{
+			PhaseElapsed: "40m0s",
+			ProgressionElapsed: report.ValidatedDuration{
+				Validated: report.Validated{
+					State: report.OK,
+				},
+				Value: 10 * stdtime.Minute,
+			},
+		}
+		checkApplicationsNotEqual(t, a1, a2)
+	})
 	t.Run("hub drpc phase", func(t *testing.T) {
 		a2 := testApplicationStatus()
 		a2.Hub.DRPC.Phase = report.ValidatedString{
@@ -131,6 +149,51 @@ func TestReportApplicationStatusNotEqual(t *testing.T) {
 		a2.Hub.DRPC.Conditions[0].State = report.Problem
 		checkApplicationsNotEqual(t, a1, a2)
 	})
+	t.Run("hub placement nil", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.Hub.Placement = nil
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("hub placement decision", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.Hub.Placement.Decision.Value = helpers.Modified
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster manifestworks nil", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.PrimaryCluster.ManifestWorks = nil
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster manifestworks name", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.PrimaryCluster.ManifestWorks[0].Name = helpers.Modified
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster manifestworks conditions", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.PrimaryCluster.ManifestWorks[0].Conditions[0].State = report.Problem
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster pvcs nil", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.PrimaryCluster.PVCs = nil
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster pvcs storage class", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.PrimaryCluster.PVCs[0].StorageClass = helpers.Modified
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster pvcs replication", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.PrimaryCluster.PVCs[0].Replication = report.Volsync
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster pvcs protected", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.PrimaryCluster.PVCs[0].Protected.State = report.Problem
+		checkApplicationsNotEqual(t, a1, a2)
+	})
 	t.Run("primary cluster name", func(t *testing.T) {
 		a2 := testApplicationStatus()
 		a2.PrimaryCluster.Name = helpers.Modified
@@ -258,6 +321,44 @@ func TestReportApplicationStatusNotEqual(t *testing.T) {
 		a2.PrimaryCluster.VRG.ProtectedPVCs[0].Conditions[0].State = report.Problem
 		checkApplicationsNotEqual(t, a1, a2)
 	})
+	t.Run("primary cluster vrg protectedpvcs replication resources nil", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.PrimaryCluster.VRG.ProtectedPVCs[0].ReplicationResources = nil
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster vrg protectedpvcs replication resources kind", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.PrimaryCluster.VRG.ProtectedPVCs[0].ReplicationResources[0].Kind = helpers.Modified
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster vrg protectedpvcs replication resources state", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		resource := &a2.PrimaryCluster.VRG.ProtectedPVCs[0].ReplicationResources[0]
+		resource.State.State = report.Problem
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster vrg protectedpvcs replication resources sync", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		resource := &a2.PrimaryCluster.VRG.ProtectedPVCs[0].ReplicationResources[0]
+		resource.LastSyncTime = &report.ValidatedTime{
+			Validated: report.Validated{
+				State: report.OK,
+			},
+		}
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster vrg protectedpvcs replication resources vsc", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		resource := &a2.PrimaryCluster.VRG.ProtectedPVCs[0].ReplicationResources[0]
+		resource.VolumeSnapshotClass = &report.ValidatedString{Value: helpers.Modified}
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("primary cluster vrg protectedpvcs replication resources conditions", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		resource := &a2.PrimaryCluster.VRG.ProtectedPVCs[0].ReplicationResources[0]
+		resource.Conditions[0].State = report.Problem
+		checkApplicationsNotEqual(t, a1, a2)
+	})
 	t.Run("primary cluster vrg pvcgroups nil", func(t *testing.T) {
 		a2 := testApplicationStatus()
 		a2.PrimaryCluster.VRG.PVCGroups = nil
@@ -378,6 +479,73 @@ func TestReportApplicationStatusNotEqual(t *testing.T) {
 		a2.S3.Profiles.Value[0].Gathered.Description = "connection refused"
 		checkApplicationsNotEqual(t, a1, a2)
 	})
+	t.Run("s3 status profile transport", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.S3.Profiles.Value[0].Transport = &report.S3TransportSummary{
+			ProxyURL: "http://proxy:3128",
+		}
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("vrg roles nil", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.VRGRoles = nil
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("vrg roles state", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.VRGRoles.State = report.Problem
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("vrg roles description", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.VRGRoles.Description = helpers.Modified
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("vrg roles value", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.VRGRoles.Value[1].State = "Primary"
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("kube object protection nil", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.KubeObjectProtection = nil
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("kube object protection capture interval", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.KubeObjectProtection.CaptureInterval = "10m0s"
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("kube object protection last capture time", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.KubeObjectProtection.LastCaptureTime.State = report.Warning
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("kube object protection recipe nil", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.KubeObjectProtection.Recipe = nil
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("kube object protection recipe valid", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.KubeObjectProtection.Recipe.Valid = &report.ValidatedBool{
+			Validated: report.Validated{
+				State:       report.Problem,
+				Description: "invalid recipe",
+			},
+		}
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("kube object protection recipe hooks", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.KubeObjectProtection.Recipe.Hooks[0].Resources.State = report.Problem
+		checkApplicationsNotEqual(t, a1, a2)
+	})
+	t.Run("kube object protection tooling", func(t *testing.T) {
+		a2 := testApplicationStatus()
+		a2.KubeObjectProtection.Tooling[1].Velero.Value = "openshift-adp"
+		checkApplicationsNotEqual(t, a1, a2)
+	})
 }
 
 func TestReportApplicationStatusMarshaling(t *testing.T) {
@@ -408,6 +576,7 @@ func testApplicationStatus() *report.ApplicationStatus {
 					},
 				},
 				DRPolicy: "dr-policy-1m",
+				Mode:     report.RegionalDR,
 				SchedulingInterval: report.ValidatedDuration{
 					Validated: report.Validated{
 						State: report.OK,
@@ -458,9 +627,62 @@ func testApplicationStatus() *report.ApplicationStatus {
 					},
 				},
 			},
+			Placement: &report.PlacementSummary{
+				Name:      "placement-name",
+				Namespace: "placement-namespace",
+				Deleted: report.ValidatedBool{
+					Validated: report.Validated{
+						State: report.OK,
+					},
+				},
+				Decision: report.ValidatedString{
+					Validated: report.Validated{
+						State: report.OK,
+					},
+					Value: "dr1",
+				},
+			},
 		},
 		PrimaryCluster: report.ApplicationStatusCluster{
 			Name: "dr1",
+			ManifestWorks: []report.ManifestWorkSummary{
+				{
+					Name: "manifestwork-name",
+					Deleted: report.ValidatedBool{
+						Validated: report.Validated{
+							State: report.OK,
+						},
+					},
+					Conditions: []report.ValidatedCondition{
+						{
+							Validated: report.Validated{
+								State: report.OK,
+							},
+							Type: "Applied",
+						},
+						{
+							Validated: report.Validated{
+								State: report.OK,
+							},
+							Type: "Available",
+						},
+					},
+				},
+			},
+			PVCs: []report.PVCSummary{
+				{
+					Name:         "pvc-name",
+					Namespace:    "pvc-namespace",
+					StorageClass: "storage-class",
+					Replication:  report.Volrep,
+					Protected: report.ValidatedBool{
+						Validated: report.Validated{
+							State: report.OK,
+						},
+						Value: true,
+					},
+				},
+			},
 			VRG: report.VRGSummary{
 				Name:        "vrg-name",
 				Namespace:   "vrg-namespace",
@@ -562,6 +784,32 @@ func testApplicationStatus() *report.ApplicationStatus {
 								Type: "DataProtected",
 							},
 						},
+						ReplicationResources: []report.ReplicationResourceSummary{
+							{
+								Kind:    "VolumeReplication",
+								Name:    "pvc-name",
+								Cluster: "dr1",
+								Deleted: report.ValidatedBool{
+									Validated: report.Validated{
+										State: report.OK,
+									},
+								},
+								State: &report.ValidatedString{
+									Validated: report.Validated{
+										State: report.OK,
+									},
+									Value: "Primary",
+								},
+								Conditions: []report.ValidatedCondition{
+									{
+										Validated: report.Validated{
+											State: report.OK,
+										},
+										Type: "Completed",
+									},
+								},
+							},
+						},
 					},
 				},
 				PVCGroups: []report.PVCGroupsSummary{
@@ -637,6 +885,71 @@ func testApplicationStatus() *report.ApplicationStatus {
 				},
 			},
 		},
+		VRGRoles: &report.ValidatedVRGRolesList{
+			Validated: report.Validated{
+				State: report.OK,
+			},
+			Value: []report.VRGRoleSummary{
+				{Cluster: "dr1", ReplicationState: "primary", State: "Primary"},
+				{Cluster: "dr2", ReplicationState: "secondary", State: "Secondary"},
+			},
+		},
+		KubeObjectProtection: &report.KubeObjectProtectionSummary{
+			CaptureInterval: "5m0s",
+			LastCaptureTime: report.ValidatedTime{
+				Validated: report.Validated{
+					State: report.OK,
+				},
+				Value: &now,
+			},
+			Recipe: &report.RecipeSummary{
+				Name:      "recipe-name",
+				Namespace: "recipe-namespace",
+				Deleted: report.ValidatedBool{
+					Validated: report.Validated{
+						State: report.OK,
+					},
+				},
+				Valid: &report.ValidatedBool{
+					Validated: report.Validated{
+						State: report.OK,
+					},
+					Value: true,
+				},
+				Hooks: []report.RecipeHookSummary{
+					{
+						Name:      "check-hook",
+						Namespace: "app-namespace",
+						Resources: report.ValidatedString{
+							Validated: report.Validated{
+								State: report.OK,
+							},
+							Value: "busybox",
+						},
+					},
+				},
+			},
+			Tooling: []report.KubeObjectsToolingSummary{
+				{
+					Cluster: "dr1",
+					Velero: report.ValidatedString{
+						Validated: report.Validated{
+							State: report.OK,
+						},
+						Value: "velero",
+					},
+				},
+				{
+					Cluster: "dr2",
+					Velero: report.ValidatedString{
+						Validated: report.Validated{
+							State: report.OK,
+						},
+						Value: "velero",
+					},
+				},
+			},
+		},
 	}
 	return a
 }
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import "time"
+
+// CertificateSummary is the summary of a certificate in a CA bundle. NotAfter is validated for
+// certificate expiration.
+type CertificateSummary struct {
+	Subject   string        `json:"subject"`
+	Issuer    string        `json:"issuer"`
+	NotBefore *time.Time    `json:"notBefore,omitempty"`
+	NotAfter  ValidatedTime `json:"notAfter"`
+}
+
+func (c *CertificateSummary) AggregateState() ValidationState {
+	return c.NotAfter.State
+}
+
+func (c *CertificateSummary) Equal(o *CertificateSummary) bool {
+	if c == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if c.Subject != o.Subject {
+		return false
+	}
+	if c.Issuer != o.Issuer {
+		return false
+	}
+	if (c.NotBefore == nil) != (o.NotBefore == nil) {
+		return false
+	}
+	if c.NotBefore != nil && !c.NotBefore.Equal(*o.NotBefore) {
+		return false
+	}
+	if !c.NotAfter.Equal(&o.NotAfter) {
+		return false
+	}
+	return true
+}
@@ -5,19 +5,56 @@ package report
 
 import (
 	"slices"
+	"time"
+)
+
+// DRMode is the disaster recovery mode of a DRPolicy.
+type DRMode string
+
+const (
+	// RegionalDR replicates asynchronously between clusters in different regions.
+	RegionalDR = DRMode("regional")
+	// MetroDR replicates synchronously between clusters in the same region.
+	MetroDR = DRMode("metro")
 )
 
 // DRClusterSummary is the summary of a DRCluster.
 type DRClusterSummary struct {
-	Name       string                 `json:"name"`
-	Phase      string                 `json:"phase,omitempty"`
-	Conditions ValidatedConditionList `json:"conditions,omitempty"`
+	Name             string                   `json:"name"`
+	Phase            string                   `json:"phase,omitempty"`
+	Region           string                   `json:"region,omitempty"`
+	S3Profile        *ValidatedString         `json:"s3Profile,omitempty"`
+	ClusterFence     *ValidatedString         `json:"clusterFence,omitempty"`
+	FencingReady     *ValidatedBool           `json:"fencingReady,omitempty"`
+	NetworkFences    []NetworkFenceSummary    `json:"networkFences,omitempty"`
+	MaintenanceModes []MaintenanceModeSummary `json:"maintenanceModes,omitempty"`
+	Conditions       ValidatedConditionList   `json:"conditions,omitempty"`
+}
+
+// NetworkFenceSummary is the summary of a NetworkFence fencing a DRCluster. Cluster is the managed
+// cluster running the NetworkFence.
+type NetworkFenceSummary struct {
+	Cluster    string          `json:"cluster"`
+	Name       string          `json:"name"`
+	FenceState ValidatedString `json:"fenceState"`
+	Result     ValidatedString `json:"result"`
 }
 
-// DRPolicySummary is the summary of a DRPolicy.
+// MaintenanceModeSummary is the summary of a MaintenanceMode on the DRCluster managed cluster.
+type MaintenanceModeSummary struct {
+	Name               string          `json:"name"`
+	StorageProvisioner string          `json:"storageProvisioner,omitempty"`
+	TargetID           string          `json:"targetID,omitempty"`
+	State              ValidatedString `json:"state"`
+}
+
+// DRPolicySummary is the summary of a DRPolicy. Clusters is true if all the DRPolicy clusters are
+// configured.
 type DRPolicySummary struct {
 	Name               string                   `json:"name"`
+	Mode               DRMode                   `json:"mode,omitempty"`
 	DRClusters         []string                 `json:"drClusters"`
+	Clusters           ValidatedBool            `json:"clusters"`
 	SchedulingInterval string                   `json:"schedulingInterval"`
 	PeerClasses        ValidatedPeerClassesList `json:"peerClasses"`
 	Conditions         ValidatedConditionList   `json:"conditions,omitempty"`
@@ -25,9 +62,37 @@ type DRPolicySummary struct {
 
 // PeerClassesSummary is the summary of peerClasses in a DRPolicy.
 type PeerClassesSummary struct {
-	StorageClassName string `json:"storageClassName"`
-	ReplicationID    string `json:"replicationID,omitempty"`
-	Grouping         bool   `json:"grouping,omitempty"`
+	StorageClassName string                    `json:"storageClassName"`
+	ReplicationID    string                    `json:"replicationID,omitempty"`
+	Grouping         bool                      `json:"grouping,omitempty"`
+	Clusters         []PeerClassClusterSummary `json:"clusters,omitempty"`
+}
+
+func (p *PeerClassesSummary) AggregateState() ValidationState {
+	state := ValidationState("")
+	for i := range p.Clusters {
+		state = significantState(state, p.Clusters[i].AggregateState())
+	}
+	return state
+}
+
+// PeerClassClusterSummary is the summary of the storage classes matching a peerClass on a managed
+// cluster.
+type PeerClassClusterSummary struct {
+	Name string `json:"name"`
+	// StorageClass value is the storage class storageID.
+	StorageClass ValidatedString `json:"storageClass"`
+	// ReplicationClass value is the name of the matching replication class. Validated only for
+	// peer classes using volume replication.
+	ReplicationClass *ValidatedString `json:"replicationClass,omitempty"`
+}
+
+func (p *PeerClassClusterSummary) AggregateState() ValidationState {
+	state := p.StorageClass.State
+	if p.ReplicationClass != nil {
+		state = significantState(state, p.ReplicationClass.State)
+	}
+	return state
 }
 
 // S3StoreProfilesSummary is the summary of S3 store profiles in the ConfigMap
@@ -37,17 +102,22 @@ type S3StoreProfilesSummary struct {
 	S3CompatibleEndpoint ValidatedString      `json:"endpoint"`
 	S3Region             ValidatedString      `json:"region"`
 	CACertificate        ValidatedFingerprint `json:"caCertificate"`
+	CACertificates       []CertificateSummary `json:"caCertificates,omitempty"`
 	S3SecretRef          S3SecretSummary      `json:"secret"`
 }
 
 func (p *S3StoreProfilesSummary) AggregateState() ValidationState {
-	return aggregateState(
+	state := aggregateState(
 		&p.S3Bucket,
 		&p.S3CompatibleEndpoint,
 		&p.S3Region,
 		&p.CACertificate,
 		&p.S3SecretRef,
 	)
+	for i := range p.CACertificates {
+		state = significantState(state, p.CACertificates[i].AggregateState())
+	}
+	return state
 }
 
 // S3SecretSummary is the summary of S3 Secret in the ConfigMap.
@@ -101,16 +171,33 @@ type ClustersStatusHub struct {
 	Ramen      RamenSummary            `json:"ramen"`
 }
 
+// ManagedClusterSummary is the summary of the OCM ManagedCluster on the hub.
+type ManagedClusterSummary struct {
+	Deleted    ValidatedBool          `json:"deleted"`
+	Conditions ValidatedConditionList `json:"conditions,omitempty"`
+	// Lease value is the last renew time of the managed cluster lease.
+	Lease  ValidatedTime                `json:"lease"`
+	AddOns []ManagedClusterAddOnSummary `json:"addOns,omitempty"`
+}
+
+// ManagedClusterAddOnSummary is the summary of a ManagedClusterAddOn used by ramen.
+type ManagedClusterAddOnSummary struct {
+	Name       string                 `json:"name"`
+	Conditions ValidatedConditionList `json:"conditions,omitempty"`
+}
+
 // ClustersStatusCluster is the cluster status on a managed cluster.
 type ClustersStatusCluster struct {
-	Name  string       `json:"name"`
-	Ramen RamenSummary `json:"ramen"`
+	Name           string                 `json:"name"`
+	ManagedCluster *ManagedClusterSummary `json:"managedCluster,omitempty"`
+	Ramen          RamenSummary           `json:"ramen"`
 }
 
 // ClustersS3ProfileStatus is the status of an S3 profile.
 type ClustersS3ProfileStatus struct {
-	Name       string        `json:"name"`
-	Accessible ValidatedBool `json:"accessible"`
+	Name       string              `json:"name"`
+	Accessible ValidatedBool       `json:"accessible"`
+	Transport  *S3TransportSummary `json:"transport,omitempty"`
 }
 
 // ClustersS3Status is the status of all S3 profiles.
@@ -118,11 +205,87 @@ type ClustersS3Status struct {
 	Profiles ValidatedClustersS3ProfileStatusList `json:"profiles"`
 }
 
+// OperatorVersionSummary is the version of the ramen operator on a cluster.
+type OperatorVersionSummary struct {
+	Cluster string          `json:"cluster"`
+	Image   string          `json:"image"`
+	Version ValidatedString `json:"version"`
+}
+
+// CRDSummary is the summary of a ramen CRD installed on a cluster.
+type CRDSummary struct {
+	Cluster        string          `json:"cluster"`
+	Name           string          `json:"name"`
+	ServedVersions []string        `json:"servedVersions,omitempty"`
+	StorageVersion ValidatedString `json:"storageVersion"`
+	// Schema is true if the CRD schema includes all the fields read by ramenctl.
+	Schema *ValidatedBool `json:"schema,omitempty"`
+}
+
+// ClustersVersionsStatus is the status of the ramen versions on all clusters.
+type ClustersVersionsStatus struct {
+	// APIVersion is the ramen API version ramenctl was built with.
+	APIVersion string                   `json:"apiVersion"`
+	Operators  []OperatorVersionSummary `json:"operators"`
+	CRDs       []CRDSummary             `json:"crds,omitempty"`
+}
+
+// ClusterClockSummary is the summary of a cluster clock. Time is the cluster API server time when
+// the cluster was gathered, and Skew is the minimal clock skew compared to the local host and the
+// hub.
+type ClusterClockSummary struct {
+	Cluster string            `json:"cluster"`
+	Time    *time.Time        `json:"time,omitempty"`
+	Skew    ValidatedDuration `json:"skew"`
+}
+
+// RamenConfigFieldSummary compares a ramen config field on the hub and managed clusters.
+type RamenConfigFieldSummary struct {
+	Name     string                  `json:"name"`
+	Hub      ValidatedString         `json:"hub"`
+	Clusters []RamenConfigFieldValue `json:"clusters,omitempty"`
+}
+
+// RamenConfigFieldValue is the value of a ramen config field on a managed cluster.
+type RamenConfigFieldValue struct {
+	Cluster string          `json:"cluster"`
+	Value   ValidatedString `json:"value"`
+}
+
+// BackupScheduleSummary is the summary of the hub backup schedule on the active hub.
+type BackupScheduleSummary struct {
+	Name           string          `json:"name,omitempty"`
+	Phase          ValidatedString `json:"phase"`
+	LastBackupTime ValidatedTime   `json:"lastBackupTime"`
+}
+
+// RestoreSummary is the summary of the hub restore on the passive hub.
+type RestoreSummary struct {
+	Name  string          `json:"name,omitempty"`
+	Sync  bool            `json:"sync"`
+	Phase ValidatedString `json:"phase"`
+}
+
+// ClustersStatusPassiveHub is the status of the passive hub, and the hub backup used to recover the
+// hub on the passive hub.
+type ClustersStatusPassiveHub struct {
+	Name            string                `json:"name"`
+	BackupSchedule  BackupScheduleSummary `json:"backupSchedule"`
+	Restore         RestoreSummary        `json:"restore"`
+	Ramen           RamenSummary          `json:"ramen"`
+	OperatorVersion ValidatedString       `json:"operatorVersion"`
+	S3Profiles      ValidatedBool         `json:"s3Profiles"`
+}
+
 // ClustersStatus is cluster status in multi-cluster environment.
 type ClustersStatus struct {
-	Hub      ClustersStatusHub       `json:"hub"`
-	Clusters []ClustersStatusCluster `json:"clusters"`
-	S3       ClustersS3Status        `json:"s3"`
+	Hub         ClustersStatusHub         `json:"hub"`
+	PassiveHub  *ClustersStatusPassiveHub `json:"passiveHub,omitempty"`
+	Clusters    []ClustersStatusCluster   `json:"clusters"`
+	S3          ClustersS3Status          `json:"s3"`
+	Versions    *ClustersVersionsStatus   `json:"versions,omitempty"`
+	Clocks      []ClusterClockSummary     `json:"clocks,omitempty"`
+	RamenConfig []RamenConfigFieldSummary `json:"ramenConfig,omitempty"`
 }
 
 func (c *ClustersStatus) Equal(o *ClustersStatus) bool {
@@ -135,6 +298,9 @@ func (c *ClustersStatus) Equal(o *ClustersStatus) bool {
 	if !c.Hub.Equal(&o.Hub) {
 		return false
 	}
+	if !c.PassiveHub.Equal(o.PassiveHub) {
+		return false
+	}
 	if !slices.EqualFunc(
 		c.Clusters,
 		o.Clusters,
@@ -147,6 +313,116 @@ func (c *ClustersStatus) Equal(o *ClustersStatus) bool {
 	if !c.S3.Equal(&o.S3) {
 		return false
 	}
+	if !c.Versions.Equal(o.Versions) {
+		return false
+	}
+	if !slices.EqualFunc(
+		c.Clocks,
+		o.Clocks,
+		func(a ClusterClockSummary, b ClusterClockSummary) bool {
+			return a.Equal(&b)
+		},
+	) {
+		return false
+	}
+	if !slices.EqualFunc(
+		c.RamenConfig,
+		o.RamenConfig,
+		func(a RamenConfigFieldSummary, b RamenConfigFieldSummary) bool {
+			return a.Equal(&b)
+		},
+	) {
+		return false
+	}
+	return true
+}
+
+func (f *RamenConfigFieldSummary) Equal(o *RamenConfigFieldSummary) bool {
+	if f == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if f.Name != o.Name {
+		return false
+	}
+	if f.Hub != o.Hub {
+		return false
+	}
+	if !slices.Equal(f.Clusters, o.Clusters) {
+		return false
+	}
+	return true
+}
+
+func (c *ClusterClockSummary) Equal(o *ClusterClockSummary) bool {
+	if c == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if c.Cluster != o.Cluster {
+		return false
+	}
+	if c.Time != o.Time {
+		if c.Time == nil || o.Time == nil || !c.Time.Equal(*o.Time) {
+			return false
+		}
+	}
+	if c.Skew != o.Skew {
+		return false
+	}
+	return true
+}
+
+func (v *ClustersVersionsStatus) Equal(o *ClustersVersionsStatus) bool {
+	if v == o {
+		return true
+	}
+	if v == nil || o == nil {
+		return false
+	}
+	if v.APIVersion != o.APIVersion {
+		return false
+	}
+	if !slices.Equal(v.Operators, o.Operators) {
+		return false
+	}
+	if !slices.EqualFunc(v.CRDs, o.CRDs, func(a CRDSummary, b CRDSummary) bool {
+		return a.Equal(&b)
+	}) {
+		return false
+	}
+	return true
+}
+
+func (c *CRDSummary) Equal(o *CRDSummary) bool {
+	if c == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if c.Cluster != o.Cluster {
+		return false
+	}
+	if c.Name != o.Name {
+		return false
+	}
+	if !slices.Equal(c.ServedVersions, o.ServedVersions) {
+		return false
+	}
+	if c.StorageVersion != o.StorageVersion {
+		return false
+	}
+	if (c.Schema == nil) != (o.Schema == nil) {
+		return false
+	}
+	if c.Schema != nil && *c.Schema != *o.Schema {
+		return false
+	}
 	return true
 }
 
@@ -169,6 +445,53 @@ func (h *ClustersStatusHub) Equal(o *ClustersStatusHub) bool {
 	return true
 }
 
+func (p *ClustersStatusPassiveHub) Equal(o *ClustersStatusPassiveHub) bool {
+	if p == o {
+		return true
+	}
+	if p == nil || o == nil {
+		return false
+	}
+	if p.Name != o.Name {
+		return false
+	}
+	if !p.BackupSchedule.Equal(&o.BackupSchedule) {
+		return false
+	}
+	if p.Restore != o.Restore {
+		return false
+	}
+	if !p.Ramen.Equal(&o.Ramen) {
+		return false
+	}
+	if p.OperatorVersion != o.OperatorVersion {
+		return false
+	}
+	if p.S3Profiles != o.S3Profiles {
+		return false
+	}
+	return true
+}
+
+func (b *BackupScheduleSummary) Equal(o *BackupScheduleSummary) bool {
+	if b == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if b.Name != o.Name {
+		return false
+	}
+	if b.Phase != o.Phase {
+		return false
+	}
+	if !b.LastBackupTime.Equal(&o.LastBackupTime) {
+		return false
+	}
+	return true
+}
+
 func (m *ClustersStatusCluster) Equal(o *ClustersStatusCluster) bool {
 	if m == o {
 		return true
@@ -179,12 +502,55 @@ func (m *ClustersStatusCluster) Equal(o *ClustersStatusCluster) bool {
 	if m.Name != o.Name {
 		return false
 	}
+	if !m.ManagedCluster.Equal(o.ManagedCluster) {
+		return false
+	}
 	if !m.Ramen.Equal(&o.Ramen) {
 		return false
 	}
 	return true
 }
 
+func (m *ManagedClusterSummary) Equal(o *ManagedClusterSummary) bool {
+	if m == o {
+		return true
+	}
+	if m == nil || o == nil {
+		return false
+	}
+	if m.Deleted != o.Deleted {
+		return false
+	}
+	if !slices.Equal(m.Conditions, o.Conditions) {
+		return false
+	}
+	if !m.Lease.Equal(&o.Lease) {
+		return false
+	}
+	if !slices.EqualFunc(m.AddOns, o.AddOns, func(a, b ManagedClusterAddOnSummary) bool {
+		return a.Equal(&b)
+	}) {
+		return false
+	}
+	return true
+}
+
+func (a *ManagedClusterAddOnSummary) Equal(o *ManagedClusterAddOnSummary) bool {
+	if a == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if a.Name != o.Name {
+		return false
+	}
+	if !slices.Equal(a.Conditions, o.Conditions) {
+		return false
+	}
+	return true
+}
+
 func (d *DRClusterSummary) Equal(o *DRClusterSummary) bool {
 	if d == o {
 		return true
@@ -198,6 +564,33 @@ func (d *DRClusterSummary) Equal(o *DRClusterSummary) bool {
 	if d.Phase != o.Phase {
 		return false
 	}
+	if d.Region != o.Region {
+		return false
+	}
+	if (d.S3Profile == nil) != (o.S3Profile == nil) {
+		return false
+	}
+	if d.S3Profile != nil && *d.S3Profile != *o.S3Profile {
+		return false
+	}
+	if (d.ClusterFence == nil) != (o.ClusterFence == nil) {
+		return false
+	}
+	if d.ClusterFence != nil && *d.ClusterFence != *o.ClusterFence {
+		return false
+	}
+	if (d.FencingReady == nil) != (o.FencingReady == nil) {
+		return false
+	}
+	if d.FencingReady != nil && *d.FencingReady != *o.FencingReady {
+		return false
+	}
+	if !slices.Equal(d.NetworkFences, o.NetworkFences) {
+		return false
+	}
+	if !slices.Equal(d.MaintenanceModes, o.MaintenanceModes) {
+		return false
+	}
 	if !slices.Equal(d.Conditions, o.Conditions) {
 		return false
 	}
@@ -214,9 +607,15 @@ func (d *DRPolicySummary) Equal(o *DRPolicySummary) bool {
 	if d.Name != o.Name {
 		return false
 	}
+	if d.Mode != o.Mode {
+		return false
+	}
 	if !slices.Equal(d.DRClusters, o.DRClusters) {
 		return false
 	}
+	if d.Clusters != o.Clusters {
+		return false
+	}
 	if d.SchedulingInterval != o.SchedulingInterval {
 		return false
 	}
@@ -245,6 +644,33 @@ func (p *PeerClassesSummary) Equal(o *PeerClassesSummary) bool {
 	if p.Grouping != o.Grouping {
 		return false
 	}
+	if !slices.EqualFunc(p.Clusters, o.Clusters, func(a, b PeerClassClusterSummary) bool {
+		return a.Equal(&b)
+	}) {
+		return false
+	}
+	return true
+}
+
+func (p *PeerClassClusterSummary) Equal(o *PeerClassClusterSummary) bool {
+	if p == o {
+		return true
+	}
+	if o == nil {
+		return false
+	}
+	if p.Name != o.Name {
+		return false
+	}
+	if p.StorageClass != o.StorageClass {
+		return false
+	}
+	if (p.ReplicationClass == nil) != (o.ReplicationClass == nil) {
+		return false
+	}
+	if p.ReplicationClass != nil && *p.ReplicationClass != *o.ReplicationClass {
+		return false
+	}
 	return true
 }
 
@@ -314,6 +740,12 @@ func (s *S3StoreProfilesSummary) Equal(o *S3StoreProfilesSummary) bool {
 	if s.CACertificate != o.CACertificate {
 		return false
 	}
+	if !slices.EqualFunc(s.CACertificates, o.CACertificates,
+		func(a CertificateSummary, b CertificateSummary) bool {
+			return a.Equal(&b)
+		}) {
+		return false
+	}
 	return true
 }
 
@@ -330,6 +762,9 @@ func (s *ClustersS3ProfileStatus) Equal(o *ClustersS3ProfileStatus) bool {
 	if s.Accessible != o.Accessible {
 		return false
 	}
+	if !s.Transport.Equal(o.Transport) {
+		return false
+	}
 	return true
 }
 
@@ -5,6 +5,7 @@ package report_test
 
 import (
 	"testing"
+	"time"
 
 	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
 	"sigs.k8s.io/yaml"
@@ -67,6 +68,41 @@ func TestReportClusterStatusNotEqual(t *testing.T) {
 		c2.Hub.DRClusters.Value[0].Conditions[0].State = report.Problem
 		checkClustersNotEqual(t, c1, c2)
 	})
+	t.Run("hub drcluster region", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Hub.DRClusters.Value[0].Region = helpers.Modified
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("hub drcluster s3 profile nil", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Hub.DRClusters.Value[0].S3Profile = nil
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("hub drcluster s3 profile state", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Hub.DRClusters.Value[0].S3Profile.State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("hub drcluster cluster fence", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Hub.DRClusters.Value[0].ClusterFence.Value = "Fenced"
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("hub drcluster fencing ready", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Hub.DRClusters.Value[0].FencingReady.Value = false
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("hub drcluster network fence result", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Hub.DRClusters.Value[0].NetworkFences[0].Result.State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("hub drcluster maintenance mode state", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Hub.DRClusters.Value[0].MaintenanceModes[0].State.Value = "Error"
+		checkClustersNotEqual(t, c1, c2)
+	})
 
 	// Hub drPolicies tests
 
@@ -90,11 +126,21 @@ func TestReportClusterStatusNotEqual(t *testing.T) {
 		c2.Hub.DRPolicies.Value[0].Name = helpers.Modified
 		checkClustersNotEqual(t, c1, c2)
 	})
+	t.Run("hub drpolicy mode", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Hub.DRPolicies.Value[0].Mode = report.MetroDR
+		checkClustersNotEqual(t, c1, c2)
+	})
 	t.Run("hub drpolicy drclusters", func(t *testing.T) {
 		c2 := testClusterStatus()
 		c2.Hub.DRPolicies.Value[0].DRClusters[0] = helpers.Modified
 		checkClustersNotEqual(t, c1, c2)
 	})
+	t.Run("hub drpolicy clusters", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Hub.DRPolicies.Value[0].Clusters.State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
 	t.Run("hub drpolicy scheduling interval", func(t *testing.T) {
 		c2 := testClusterStatus()
 		c2.Hub.DRPolicies.Value[0].SchedulingInterval = helpers.Modified
@@ -115,6 +161,30 @@ func TestReportClusterStatusNotEqual(t *testing.T) {
 		c2.Hub.DRPolicies.Value[0].PeerClasses.Value[0].Grouping = false
 		checkClustersNotEqual(t, c1, c2)
 	})
+	t.Run("hub drpolicy peer classes cluster name", func(t *testing.T) {
+		c2 := testClusterStatus()
+		cluster := &c2.Hub.DRPolicies.Value[0].PeerClasses.Value[0].Clusters[0]
+		cluster.Name = helpers.Modified
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("hub drpolicy peer classes cluster storage class", func(t *testing.T) {
+		c2 := testClusterStatus()
+		cluster := &c2.Hub.DRPolicies.Value[0].PeerClasses.Value[0].Clusters[0]
+		cluster.StorageClass.State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("hub drpolicy peer classes cluster replication class", func(t *testing.T) {
+		c2 := testClusterStatus()
+		cluster := &c2.Hub.DRPolicies.Value[0].PeerClasses.Value[0].Clusters[0]
+		cluster.ReplicationClass.Value = helpers.Modified
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("hub drpolicy peer classes cluster replication class nil", func(t *testing.T) {
+		c2 := testClusterStatus()
+		cluster := &c2.Hub.DRPolicies.Value[0].PeerClasses.Value[0].Clusters[0]
+		cluster.ReplicationClass = nil
+		checkClustersNotEqual(t, c1, c2)
+	})
 	t.Run("hub drpolicy conditions", func(t *testing.T) {
 		c2 := testClusterStatus()
 		c2.Hub.DRPolicies.Value[0].Conditions[0].State = report.Problem
@@ -333,6 +403,37 @@ func TestReportClusterStatusNotEqual(t *testing.T) {
 		c2.Clusters[0].Name = helpers.Modified
 		checkClustersNotEqual(t, c1, c2)
 	})
+	t.Run("cluster managed cluster nil", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Clusters[0].ManagedCluster = nil
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("cluster managed cluster deleted", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Clusters[0].ManagedCluster.Deleted.State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("cluster managed cluster conditions", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Clusters[0].ManagedCluster.Conditions[0].State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("cluster managed cluster lease", func(t *testing.T) {
+		c2 := testClusterStatus()
+		renewTime := c2.Clusters[0].ManagedCluster.Lease.Value.Add(-time.Minute)
+		c2.Clusters[0].ManagedCluster.Lease.Value = &renewTime
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("cluster managed cluster addon name", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Clusters[0].ManagedCluster.AddOns[0].Name = helpers.Modified
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("cluster managed cluster addon conditions", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Clusters[0].ManagedCluster.AddOns[0].Conditions[0].State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
 	t.Run("cluster ramen configmap name", func(t *testing.T) {
 		c2 := testClusterStatus()
 		c2.Clusters[0].Ramen.ConfigMap.Name = helpers.Modified
@@ -512,6 +613,132 @@ func TestReportClusterStatusNotEqual(t *testing.T) {
 		c2.S3.Profiles.Value[0].Accessible.Description = "connection refused"
 		checkClustersNotEqual(t, c1, c2)
 	})
+	t.Run("s3 status profile transport", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.S3.Profiles.Value[0].Transport = &report.S3TransportSummary{
+			InsecureSkipTLSVerify: true,
+		}
+		checkClustersNotEqual(t, c1, c2)
+	})
+
+	// Versions tests
+
+	t.Run("versions nil", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Versions = nil
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("versions api version", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Versions.APIVersion = "ramendr.openshift.io/v1beta1"
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("versions operator version", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Versions.Operators[0].Version.Value = "v0.2.0"
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("versions operator version state", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Versions.Operators[0].Version.State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("versions crd served versions", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Versions.CRDs[0].ServedVersions = nil
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("versions crd storage version state", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Versions.CRDs[0].StorageVersion.State = report.Warning
+		checkClustersNotEqual(t, c1, c2)
+	})
+
+	// Passive hub tests
+
+	t.Run("passive hub nil", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.PassiveHub = nil
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("passive hub name", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.PassiveHub.Name = helpers.Modified
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("passive hub backup schedule phase", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.PassiveHub.BackupSchedule.Phase.State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("passive hub backup schedule last backup time", func(t *testing.T) {
+		c2 := testClusterStatus()
+		modified := c2.PassiveHub.BackupSchedule.LastBackupTime.Value.Add(time.Second)
+		c2.PassiveHub.BackupSchedule.LastBackupTime.Value = &modified
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("passive hub restore sync", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.PassiveHub.Restore.Sync = false
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("passive hub restore phase", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.PassiveHub.Restore.Phase.Value = helpers.Modified
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("passive hub ramen deployment name", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.PassiveHub.Ramen.Deployment.Name = helpers.Modified
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("passive hub operator version", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.PassiveHub.OperatorVersion.Value = "v0.2.0"
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("passive hub s3 profiles", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.PassiveHub.S3Profiles.State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
+
+	// Clocks tests
+
+	t.Run("clocks nil", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Clocks = nil
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("clocks time", func(t *testing.T) {
+		c2 := testClusterStatus()
+		modified := c2.Clocks[0].Time.Add(time.Second)
+		c2.Clocks[0].Time = &modified
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("ramen config nil", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.RamenConfig = nil
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("ramen config hub value", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.RamenConfig[0].Hub.Value = helpers.Modified
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("ramen config cluster value state", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.RamenConfig[0].Clusters[0].Value.State = report.Problem
+		checkClustersNotEqual(t, c1, c2)
+	})
+	t.Run("clocks skew", func(t *testing.T) {
+		c2 := testClusterStatus()
+		c2.Clocks[0].Skew = report.ValidatedDuration{
+			Validated: report.Validated{State: report.Warning},
+			Value:     2 * time.Minute,
+		}
+		checkClustersNotEqual(t, c1, c2)
+	})
 }
 
 func TestReportClusterStatusMarshaling(t *testing.T) {
@@ -528,6 +755,7 @@ func TestReportClusterStatusMarshaling(t *testing.T) {
 }
 
 func testClusterStatus() *report.ClustersStatus {
+	leaseRenewTime := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
 	c := &report.ClustersStatus{
 		Hub: report.ClustersStatusHub{
 			DRClusters: report.ValidatedDRClustersList{
@@ -536,8 +764,46 @@ func testClusterStatus() *report.ClustersStatus {
 				},
 				Value: []report.DRClusterSummary{
 					{
-						Name:  "dr1",
-						Phase: "Available",
+						Name:   "dr1",
+						Phase:  "Available",
+						Region: "east",
+						S3Profile: &report.ValidatedString{
+							Validated: report.Validated{State: report.OK},
+							Value:     "minio-on-dr1",
+						},
+						ClusterFence: &report.ValidatedString{
+							Validated: report.Validated{State: report.OK},
+							Value:     "Unfenced",
+						},
+						FencingReady: &report.ValidatedBool{
+							Validated: report.Validated{State: report.OK},
+							Value:     true,
+						},
+						NetworkFences: []report.NetworkFenceSummary{
+							{
+								Cluster: "dr2",
+								Name:    "network-fence-dr1",
+								FenceState: report.ValidatedString{
+									Validated: report.Validated{State: report.OK},
+									Value:     "Unfenced",
+								},
+								Result: report.ValidatedString{
+									Validated: report.Validated{State: report.OK},
+									Value:     "Succeeded",
+								},
+							},
+						},
+						MaintenanceModes: []report.MaintenanceModeSummary{
+							{
+								Name:               "rbd-pool",
+								StorageProvisioner: "rbd.csi.ceph.com",
+								TargetID:           "pool-id",
+								State: report.ValidatedString{
+									Validated: report.Validated{State: report.Warning},
+									Value:     "Completed",
+								},
+							},
+						},
 						Conditions: []report.ValidatedCondition{
 							{
 								Validated: report.Validated{
@@ -591,8 +857,13 @@ func testClusterStatus() *report.ClustersStatus {
 				},
 				Value: []report.DRPolicySummary{
 					{
-						Name:               "dr-policy-1m",
-						DRClusters:         []string{"dr1", "dr2"},
+						Name:       "dr-policy-1m",
+						Mode:       report.RegionalDR,
+						DRClusters: []string{"dr1", "dr2"},
+						Clusters: report.ValidatedBool{
+							Validated: report.Validated{State: report.OK},
+							Value:     true,
+						},
 						SchedulingInterval: "1m",
 						PeerClasses: report.ValidatedPeerClassesList{
 							Validated: report.Validated{
@@ -603,6 +874,19 @@ func testClusterStatus() *report.ClustersStatus {
 									StorageClassName: "rook-ceph-block",
 									ReplicationID:    "rook-ceph-replication-1",
 									Grouping:         true,
+									Clusters: []report.PeerClassClusterSummary{
+										{
+											Name: "dr1",
+											StorageClass: report.ValidatedString{
+												Validated: report.Validated{State: report.OK},
+												Value:     "rook-ceph-block-dr1-1",
+											},
+											ReplicationClass: &report.ValidatedString{
+												Validated: report.Validated{State: report.OK},
+												Value:     "vgrc-1m",
+											},
+										},
+									},
 								},
 								{
 									StorageClassName: "rook-cephfs-fs1",
@@ -620,8 +904,12 @@ func testClusterStatus() *report.ClustersStatus {
 						},
 					},
 					{
-						Name:               "dr-policy-5m",
-						DRClusters:         []string{"dr1", "dr2"},
+						Name:       "dr-policy-5m",
+						DRClusters: []string{"dr1", "dr2"},
+						Clusters: report.ValidatedBool{
+							Validated: report.Validated{State: report.OK},
+							Value:     true,
+						},
 						SchedulingInterval: "5m",
 						PeerClasses: report.ValidatedPeerClassesList{
 							Validated: report.Validated{
@@ -791,6 +1079,32 @@ func testClusterStatus() *report.ClustersStatus {
 		Clusters: []report.ClustersStatusCluster{
 			{
 				Name: "dr1",
+				ManagedCluster: &report.ManagedClusterSummary{
+					Deleted: report.ValidatedBool{
+						Validated: report.Validated{State: report.OK},
+					},
+					Conditions: []report.ValidatedCondition{
+						{
+							Validated: report.Validated{State: report.OK},
+							Type:      "ManagedClusterJoined",
+						},
+					},
+					Lease: report.ValidatedTime{
+						Validated: report.Validated{State: report.OK},
+						Value:     &leaseRenewTime,
+					},
+					AddOns: []report.ManagedClusterAddOnSummary{
+						{
+							Name: "application-manager",
+							Conditions: []report.ValidatedCondition{
+								{
+									Validated: report.Validated{State: report.OK},
+									Type:      "Available",
+								},
+							},
+						},
+					},
+				},
 				Ramen: report.RamenSummary{
 					ConfigMap: report.ConfigMapSummary{
 						Name:      ramen.DrClusterOperatorConfigMapName,
@@ -1095,6 +1409,93 @@ func testClusterStatus() *report.ClustersStatus {
 				},
 			},
 		},
+		Versions: &report.ClustersVersionsStatus{
+			APIVersion: "ramendr.openshift.io/v1alpha1",
+			Operators: []report.OperatorVersionSummary{
+				{
+					Cluster: "hub",
+					Image:   "quay.io/ramendr/ramen-operator:v0.1.0",
+					Version: report.ValidatedString{
+						Validated: report.Validated{State: report.OK},
+						Value:     "v0.1.0",
+					},
+				},
+			},
+			CRDs: []report.CRDSummary{
+				{
+					Cluster:        "hub",
+					Name:           "drpolicies.ramendr.openshift.io",
+					ServedVersions: []string{"v1alpha1"},
+					StorageVersion: report.ValidatedString{
+						Validated: report.Validated{State: report.OK},
+						Value:     "v1alpha1",
+					},
+				},
+			},
+		},
+		PassiveHub: &report.ClustersStatusPassiveHub{
+			Name: "passive-hub",
+			BackupSchedule: report.BackupScheduleSummary{
+				Name: "schedule-acm",
+				Phase: report.ValidatedString{
+					Validated: report.Validated{State: report.OK},
+					Value:     "Enabled",
+				},
+				LastBackupTime: report.ValidatedTime{
+					Validated: report.Validated{State: report.OK},
+					Value:     &leaseRenewTime,
+				},
+			},
+			Restore: report.RestoreSummary{
+				Name: "restore-acm-passive-sync",
+				Sync: true,
+				Phase: report.ValidatedString{
+					Validated: report.Validated{State: report.OK},
+					Value:     "Enabled",
+				},
+			},
+			Ramen: report.RamenSummary{
+				Deployment: report.DeploymentSummary{
+					Name:      "ramen-hub-operator",
+					Namespace: "ramen-system",
+				},
+			},
+			OperatorVersion: report.ValidatedString{
+				Validated: report.Validated{State: report.OK},
+				Value:     "v0.1.0",
+			},
+			S3Profiles: report.ValidatedBool{
+				Validated: report.Validated{State: report.OK},
+				Value:     true,
+			},
+		},
+		RamenConfig: []report.RamenConfigFieldSummary{
+			{
+				Name: "ramenOpsNamespace",
+				Hub: report.ValidatedString{
+					Validated: report.Validated{State: report.OK},
+					Value:     "ramen-ops",
+				},
+				Clusters: []report.RamenConfigFieldValue{
+					{
+						Cluster: "dr1",
+						Value: report.ValidatedString{
+							Validated: report.Validated{State: report.OK},
+							Value:     "ramen-ops",
+						},
+					},
+				},
+			},
+		},
+		Clocks: []report.ClusterClockSummary{
+			{
+				Cluster: "hub",
+				Time:    &leaseRenewTime,
+				Skew: report.ValidatedDuration{
+					Validated: report.Validated{State: report.OK},
+				},
+			},
+		},
 	}
 	return c
 }
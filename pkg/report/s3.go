@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	"github.com/ramendr/ramenctl/pkg/s3"
+)
+
+// S3TransportSummary is the effective transport used to access an S3 profile. The proxy URL
+// password is redacted.
+type S3TransportSummary struct {
+	ProxyURL              string `json:"proxyURL,omitempty"`
+	CABundle              string `json:"caBundle,omitempty"`
+	InsecureSkipTLSVerify bool   `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// NewS3TransportSummary returns a summary of the transport, or nil if the transport uses the
+// default settings.
+func NewS3TransportSummary(t s3.Transport) *S3TransportSummary {
+	if t.IsDefault() {
+		return nil
+	}
+	return &S3TransportSummary{
+		ProxyURL:              t.RedactedProxyURL(),
+		CABundle:              t.CABundleFile,
+		InsecureSkipTLSVerify: t.InsecureSkipTLSVerify,
+	}
+}
+
+func (s *S3TransportSummary) Equal(o *S3TransportSummary) bool {
+	if s == o {
+		return true
+	}
+	if s == nil || o == nil {
+		return false
+	}
+	return *s == *o
+}
@@ -196,6 +196,20 @@ type ValidatedPeerClassesList struct {
 	Value []PeerClassesSummary `json:"value,omitempty"`
 }
 
+func (l *ValidatedPeerClassesList) AggregateState() ValidationState {
+	state := l.State
+	for i := range l.Value {
+		state = significantState(state, l.Value[i].AggregateState())
+	}
+	return state
+}
+
+// ValidatedVRGRolesList is a validated list of the application VRG roles on all managed clusters.
+type ValidatedVRGRolesList struct {
+	Validated
+	Value []VRGRoleSummary `json:"value,omitempty"`
+}
+
 func (v *Validated) GetState() ValidationState {
 	return v.State
 }
@@ -308,6 +322,25 @@ func (v *ValidatedPeerClassesList) Equal(o *ValidatedPeerClassesList) bool {
 	return true
 }
 
+func (v *ValidatedVRGRolesList) Equal(o *ValidatedVRGRolesList) bool {
+	if v == o {
+		return true
+	}
+	if v == nil || o == nil {
+		return false
+	}
+	if v.State != o.State {
+		return false
+	}
+	if v.Description != o.Description {
+		return false
+	}
+	if !slices.Equal(v.Value, o.Value) {
+		return false
+	}
+	return true
+}
+
 func (v *ValidatedS3StoreProfilesList) Equal(o *ValidatedS3StoreProfilesList) bool {
 	if v == o {
 		return true
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// ParseCertificates returns all certificates in a PEM bundle, such as the S3 profile CA
+// certificates.
+func ParseCertificates(certPem []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := certPem
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("failed to decode PEM, no certificates found")
+	}
+
+	return certs, nil
+}
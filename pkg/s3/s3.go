@@ -7,20 +7,28 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
+	stdtime "time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go/logging"
 	"go.uber.org/zap"
+	"sigs.k8s.io/yaml"
 
 	"github.com/ramendr/ramenctl/pkg/time"
 )
@@ -31,6 +39,12 @@ const (
 
 	// S3 output directory permission
 	dirPerm = 0o750
+
+	// S3 manifest file permission
+	filePerm = 0o640
+
+	// Suffix of the manifest file describing the objects in a profile.
+	manifestSuffix = ".manifest.yaml"
 )
 
 // Profile contains S3 connection and authentication information.
@@ -42,6 +56,20 @@ type Profile struct {
 	CACertificate      []byte
 	AWSAccessKeyID     []byte
 	AWSSecretAccessKey []byte
+	Transport          Transport
+}
+
+// Transport configures the HTTP transport for accessing the S3 store. The zero value uses the
+// default transport.
+type Transport struct {
+	// ProxyURL overrides the proxy configured in the environment.
+	ProxyURL string
+
+	// CABundleFile is a path to a PEM file with extra CA certificates.
+	CABundleFile string
+
+	// InsecureSkipTLSVerify disables verification of the S3 endpoint certificate.
+	InsecureSkipTLSVerify bool
 }
 
 // Result represents the result of gathering from an S3 profile.
@@ -49,22 +77,70 @@ type Result struct {
 	ProfileName string
 	Err         error
 	Duration    float64
+
+	// Objects is the number of objects found by Gather, including skipped objects.
+	Objects int
+}
+
+// GatherOptions limit the objects downloaded by Gather. The zero value downloads all objects.
+type GatherOptions struct {
+	// MaxTotalBytes limits the total size of downloaded objects per profile. Zero means no limit.
+	MaxTotalBytes int64
+
+	// MaxObjectSize skips objects larger than this size. Zero means no limit.
+	MaxObjectSize int64
+
+	// ModifiedAfter skips objects modified before this time. Zero time means no limit.
+	ModifiedAfter time.Time
+
+	// ModifiedBefore skips objects modified after this time. Zero time means no limit.
+	ModifiedBefore time.Time
+
+	// ManifestOnly lists the objects in the manifest without downloading them.
+	ManifestOnly bool
+}
+
+// Manifest describes the objects found in a profile bucket.
+type Manifest struct {
+	Profile string           `json:"profile"`
+	Bucket  string           `json:"bucket"`
+	Objects []ManifestObject `json:"objects"`
+}
+
+// ManifestObject describes an object in the bucket. Objects skipped because of gather options
+// include the reason.
+type ManifestObject struct {
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified time.Time `json:"lastModified"`
+	Downloaded   bool      `json:"downloaded"`
+	Skipped      string    `json:"skipped,omitempty"`
 }
 
 // objectStore wraps an S3 client with profile information and log.
 type objectStore struct {
 	client  *s3.Client
 	profile *Profile
+	options GatherOptions
 	log     *zap.SugaredLogger
+
+	// manifest records the objects found during gather.
+	manifest Manifest
+
+	// downloadedBytes is the total size of objects downloaded from the profile.
+	downloadedBytes int64
 }
 
-// Gather gathers S3 data from all profiles in parallel. Returns a channel for
-// getting gather results.
+// Gather gathers S3 data from all profiles in parallel. Objects are filtered using options, and all
+// objects found are recorded in a manifest file for each profile. Returns a channel for getting
+// gather results.
 func Gather(
 	ctx context.Context,
 	profiles []*Profile,
 	prefixes []string,
 	outputDir string,
+	options GatherOptions,
 	log *zap.SugaredLogger,
 ) <-chan Result {
 	results := make(chan Result)
@@ -76,11 +152,12 @@ func Gather(
 		go func() {
 			defer wg.Done()
 			start := time.Now()
-			err := gatherData(ctx, profile, prefixes, outputDir, log)
+			objects, err := gatherData(ctx, profile, prefixes, outputDir, options, log)
 			results <- Result{
 				ProfileName: profile.Name,
 				Err:         err,
 				Duration:    time.Since(start).Seconds(),
+				Objects:     objects,
 			}
 		}()
 	}
@@ -126,19 +203,21 @@ func Check(
 }
 
 // gatherData creates client for the given profile and downloads objects from S3
-// using the provided prefixes.
+// using the provided prefixes. Returns the number of objects found.
 func gatherData(
 	ctx context.Context,
 	profile *Profile,
 	prefixes []string,
 	outputDir string,
+	options GatherOptions,
 	log *zap.SugaredLogger,
-) error {
+) (int, error) {
 	objectStore, err := newObjectStore(ctx, profile, log)
 	if err != nil {
-		return fmt.Errorf("failed to create S3 client for profile %q: %w",
+		return 0, fmt.Errorf("failed to create S3 client for profile %q: %w",
 			profile.Name, err)
 	}
+	objectStore.options = options
 
 	var errs []error
 	for _, prefix := range prefixes {
@@ -147,12 +226,17 @@ func gatherData(
 		}
 	}
 
+	if err := objectStore.writeManifest(outputDir); err != nil {
+		errs = append(errs, err)
+	}
+
+	objects := len(objectStore.manifest.Objects)
 	if len(errs) > 0 {
-		return fmt.Errorf("failed to download objects from profile %q: %w",
+		return objects, fmt.Errorf("failed to download objects from profile %q: %w",
 			profile.Name, errors.Join(errs...))
 	}
 
-	return nil
+	return objects, nil
 }
 
 // checkBucket creates client for the given profile and checks if the bucket is accessible.
@@ -175,6 +259,10 @@ func checkBucket(
 	if err != nil {
 		log.Warnf("Failed to access bucket %q for profile %q: %v",
 			profile.Bucket, profile.Name, err)
+		if verifyErr := certificateVerificationError(err); verifyErr != nil {
+			return fmt.Errorf("endpoint %q certificate does not verify against the CA bundle "+
+				"for profile %q: %w", profile.Endpoint, profile.Name, verifyErr)
+		}
 		return fmt.Errorf("failed to access bucket %q for profile %q",
 			profile.Bucket, profile.Name)
 	}
@@ -182,6 +270,93 @@ func checkBucket(
 	return nil
 }
 
+// certificateVerificationError returns the certificate verification error if the error was caused
+// by the endpoint certificate chain not verifying against the CA bundle, or nil.
+func certificateVerificationError(err error) error {
+	var verifyErr *tls.CertificateVerificationError
+	if errors.As(err, &verifyErr) {
+		return verifyErr.Err
+	}
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	if errors.As(err, &unknownAuthorityErr) {
+		return unknownAuthorityErr
+	}
+	var invalidErr x509.CertificateInvalidError
+	if errors.As(err, &invalidErr) {
+		return invalidErr
+	}
+	var hostnameErr x509.HostnameError
+	if errors.As(err, &hostnameErr) {
+		return hostnameErr
+	}
+	return nil
+}
+
+// IsDefault returns true if the transport uses the default settings.
+func (t *Transport) IsDefault() bool {
+	return *t == Transport{}
+}
+
+// RedactedProxyURL returns the proxy URL with the password replaced by "xxxxx", safe for logs and
+// reports.
+func (t *Transport) RedactedProxyURL() string {
+	if t.ProxyURL == "" {
+		return ""
+	}
+	u, err := url.Parse(t.ProxyURL)
+	if err != nil {
+		return "(invalid)"
+	}
+	return u.Redacted()
+}
+
+// httpClient creates an HTTP client using the transport settings. The profile CA certificate is
+// added to the client root CAs by the AWS SDK.
+func (t *Transport) httpClient() (*awshttp.BuildableClient, error) {
+	var proxyURL *url.URL
+	if t.ProxyURL != "" {
+		u, err := url.Parse(t.ProxyURL)
+		if err != nil {
+			// The error includes the URL, which may include credentials.
+			return nil, fmt.Errorf("invalid proxy URL %q", t.RedactedProxyURL())
+		}
+		proxyURL = u
+	}
+
+	var rootCAs *x509.CertPool
+	if t.CABundleFile != "" {
+		pemCerts, err := os.ReadFile(t.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		// The CA bundle is trusted in addition to the system roots.
+		rootCAs, err = x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pemCerts) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %q", t.CABundleFile)
+		}
+	}
+
+	client := awshttp.NewBuildableClient().WithTransportOptions(func(tr *http.Transport) {
+		if proxyURL != nil {
+			tr.Proxy = http.ProxyURL(proxyURL)
+		}
+		if rootCAs != nil || t.InsecureSkipTLSVerify {
+			if tr.TLSClientConfig == nil {
+				tr.TLSClientConfig = &tls.Config{}
+			}
+			if rootCAs != nil {
+				tr.TLSClientConfig.RootCAs = rootCAs
+			}
+			tr.TLSClientConfig.InsecureSkipVerify = t.InsecureSkipTLSVerify
+		}
+	})
+
+	return client, nil
+}
+
 // newObjectStore creates an S3 client for the given profile.
 func newObjectStore(
 	ctx context.Context,
@@ -203,6 +378,19 @@ func newObjectStore(
 		config.WithLogger(awsSDKLogger(log)),
 	}
 
+	// Use a custom HTTP client if the transport is configured for the profile.
+	if !profile.Transport.IsDefault() {
+		log.Debugf("Using transport (proxy: %q, CA bundle: %q, insecure: %v) for S3 profile %q",
+			profile.Transport.RedactedProxyURL(), profile.Transport.CABundleFile,
+			profile.Transport.InsecureSkipTLSVerify, profile.Name)
+		httpClient, err := profile.Transport.httpClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure transport for profile %q: %w",
+				profile.Name, err)
+		}
+		configOptions = append(configOptions, config.WithHTTPClient(httpClient))
+	}
+
 	// Add CA certificate to the client config if provided in the profile.
 	if len(profile.CACertificate) > 0 {
 		log.Debugf("Using custom CA certificate for S3 profile %q", profile.Name)
@@ -228,6 +416,11 @@ func newObjectStore(
 		client:  s3Client,
 		profile: profile,
 		log:     log,
+		manifest: Manifest{
+			Profile: profile.Name,
+			Bucket:  profile.Bucket,
+			Objects: []ManifestObject{},
+		},
 	}, nil
 }
 
@@ -252,7 +445,7 @@ func (s *objectStore) downloadObjects(ctx context.Context, prefix, outputDir str
 
 	paginator := s3.NewListObjectsV2Paginator(s.client, input)
 
-	var total, failed int
+	var total, downloaded, skipped, failed int
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
@@ -263,12 +456,30 @@ func (s *objectStore) downloadObjects(ctx context.Context, prefix, outputDir str
 		}
 		for _, obj := range page.Contents {
 			total++
-			if err := s.downloadObject(ctx, *obj.Key, profileDir); err != nil {
+			entry := manifestObject(obj)
+			if reason := s.options.skipReason(entry, s.downloadedBytes); reason != "" {
+				s.log.Debugf("Skipping object %q from bucket %q: %s",
+					entry.Key, s.profile.Bucket, reason)
+				entry.Skipped = reason
+				s.manifest.Objects = append(s.manifest.Objects, entry)
+				skipped++
+				continue
+			}
+			if s.options.ManifestOnly {
+				s.manifest.Objects = append(s.manifest.Objects, entry)
+				continue
+			}
+			if err := s.downloadObject(ctx, entry.Key, profileDir); err != nil {
 				s.log.Warnf("Failed to download object %q from bucket %q: %v",
-					*obj.Key, s.profile.Bucket, err)
+					entry.Key, s.profile.Bucket, err)
+				s.manifest.Objects = append(s.manifest.Objects, entry)
 				failed++
 				continue
 			}
+			entry.Downloaded = true
+			s.downloadedBytes += entry.Size
+			downloaded++
+			s.manifest.Objects = append(s.manifest.Objects, entry)
 		}
 	}
 
@@ -277,17 +488,75 @@ func (s *objectStore) downloadObjects(ctx context.Context, prefix, outputDir str
 			s.profile.Bucket, prefix)
 	}
 
+	if s.options.ManifestOnly {
+		s.log.Debugf("Listed %d objects from bucket %q in %.3f seconds",
+			total, s.profile.Bucket, time.Since(start).Seconds())
+		return nil
+	}
+
+	s.log.Debugf("Downloaded %d objects (%d skipped, %d failed) from bucket %q in %.3f seconds",
+		downloaded, skipped, failed, s.profile.Bucket, time.Since(start).Seconds())
+
 	if failed > 0 {
 		return fmt.Errorf("failed to download %d of %d objects from bucket %q",
 			failed, total, s.profile.Bucket)
 	}
 
-	s.log.Debugf("Downloaded %d objects from bucket %q in %.3f seconds",
-		total, s.profile.Bucket, time.Since(start).Seconds())
+	return nil
+}
+
+// writeManifest writes the manifest describing all objects found in the profile bucket.
+func (s *objectStore) writeManifest(outputDir string) error {
+	s3Dir := filepath.Join(outputDir, dirName)
+	if err := os.MkdirAll(s3Dir, dirPerm); err != nil {
+		return fmt.Errorf("failed to create directory %q: %w", s3Dir, err)
+	}
+
+	data, err := yaml.Marshal(s.manifest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest for profile %q: %w", s.profile.Name, err)
+	}
+
+	manifestPath := filepath.Join(s3Dir, s.profile.Name+manifestSuffix)
+	if err := os.WriteFile(manifestPath, data, filePerm); err != nil {
+		return fmt.Errorf("failed to write manifest %q: %w", manifestPath, err)
+	}
 
 	return nil
 }
 
+// skipReason returns the reason for skipping the object, or an empty string if the object should
+// be gathered. downloadedBytes is the total size of objects downloaded so far.
+func (o *GatherOptions) skipReason(obj ManifestObject, downloadedBytes int64) string {
+	if o.MaxObjectSize > 0 && obj.Size > o.MaxObjectSize {
+		return fmt.Sprintf("object size %d exceeds maximum object size %d",
+			obj.Size, o.MaxObjectSize)
+	}
+	if !o.ModifiedAfter.IsZero() && obj.LastModified.Before(o.ModifiedAfter) {
+		return fmt.Sprintf("object modified before %s", o.ModifiedAfter.Format(stdtime.RFC3339))
+	}
+	if !o.ModifiedBefore.IsZero() && obj.LastModified.After(o.ModifiedBefore) {
+		return fmt.Sprintf("object modified after %s", o.ModifiedBefore.Format(stdtime.RFC3339))
+	}
+	if !o.ManifestOnly && o.MaxTotalBytes > 0 && downloadedBytes+obj.Size > o.MaxTotalBytes {
+		return fmt.Sprintf("maximum total size %d reached", o.MaxTotalBytes)
+	}
+	return ""
+}
+
+// manifestObject creates a manifest object from an S3 object.
+func manifestObject(obj types.Object) ManifestObject {
+	entry := ManifestObject{
+		Key:  aws.ToString(obj.Key),
+		Size: aws.ToInt64(obj.Size),
+		ETag: strings.Trim(aws.ToString(obj.ETag), `"`),
+	}
+	if obj.LastModified != nil {
+		entry.LastModified = *obj.LastModified
+	}
+	return entry
+}
+
 // downloadObject downloads and decompresses an object from S3 store.
 func (s *objectStore) downloadObject(ctx context.Context, key, profileDir string) error {
 	start := time.Now()
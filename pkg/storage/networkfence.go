@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+)
+
+const (
+	// NetworkFence fence states.
+	FenceStateFenced   = "Fenced"
+	FenceStateUnfenced = "Unfenced"
+
+	// NetworkFenceResultSucceeded is the result of a successful fencing operation.
+	NetworkFenceResultSucceeded = "Succeeded"
+
+	// We don't depend on csi-addons apis, the resources are parsed to NetworkFence.
+	networkFenceResource = "csiaddons.openshift.io/networkfences"
+)
+
+// NetworkFence is the part of csi-addons NetworkFence used to validate cluster fencing.
+type NetworkFence struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              NetworkFenceSpec   `json:"spec"`
+	Status            NetworkFenceStatus `json:"status"`
+}
+
+// NetworkFenceSpec is the spec of a NetworkFence.
+type NetworkFenceSpec struct {
+	FenceState string   `json:"fenceState,omitempty"`
+	Cidrs      []string `json:"cidrs,omitempty"`
+}
+
+// NetworkFenceStatus is the status of a NetworkFence.
+type NetworkFenceStatus struct {
+	Result  string `json:"result,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ListNetworkFences lists network fences from the output directory.
+func ListNetworkFences(reader gathering.OutputReader) ([]string, error) {
+	return reader.ListResources("", networkFenceResource)
+}
+
+// ReadNetworkFence reads a network fence from the output directory.
+func ReadNetworkFence(reader gathering.OutputReader, name string) (*NetworkFence, error) {
+	data, err := reader.ReadResource("", networkFenceResource, name)
+	if err != nil {
+		return nil, err
+	}
+	nf := &NetworkFence{}
+	if err := yaml.Unmarshal(data, nf); err != nil {
+		return nil, err
+	}
+	return nf, nil
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+)
+
+const (
+	// VolumeReplicationKind and VolumeGroupReplicationKind are the kinds of the csi-addons
+	// replication resources.
+	VolumeReplicationKind      = "VolumeReplication"
+	VolumeGroupReplicationKind = "VolumeGroupReplication"
+
+	// VolumeReplication and VolumeGroupReplication condition types.
+	ReplicationConditionCompleted = "Completed"
+	ReplicationConditionDegraded  = "Degraded"
+	ReplicationConditionResyncing = "Resyncing"
+
+	// VolumeReplication and VolumeGroupReplication states.
+	ReplicationStatePrimary   = "Primary"
+	ReplicationStateSecondary = "Secondary"
+
+	volumeReplicationResource      = replicationGroup + "/volumereplications"
+	volumeGroupReplicationResource = replicationGroup + "/volumegroupreplications"
+)
+
+// VolumeReplication is the part of VolumeReplication or VolumeGroupReplication used to validate
+// the replication of protected PVCs.
+type VolumeReplication struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Status            VolumeReplicationStatus `json:"status"`
+}
+
+// VolumeReplicationStatus is the status of a VolumeReplication or VolumeGroupReplication.
+type VolumeReplicationStatus struct {
+	State      string             `json:"state,omitempty"`
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// PVCRefs are the PVCs replicated by a VolumeGroupReplication.
+	PVCRefs []corev1.LocalObjectReference `json:"persistentVolumeClaimsRefs,omitempty"`
+}
+
+// ListVolumeReplications lists volume replications in namespace from the output directory.
+func ListVolumeReplications(reader gathering.OutputReader, namespace string) ([]string, error) {
+	return reader.ListResources(namespace, volumeReplicationResource)
+}
+
+// ReadVolumeReplication reads a volume replication from the output directory.
+func ReadVolumeReplication(
+	reader gathering.OutputReader,
+	name, namespace string,
+) (*VolumeReplication, error) {
+	data, err := reader.ReadResource(namespace, volumeReplicationResource, name)
+	if err != nil {
+		return nil, err
+	}
+	vr := &VolumeReplication{}
+	if err := yaml.Unmarshal(data, vr); err != nil {
+		return nil, err
+	}
+	return vr, nil
+}
+
+// ReadVolumeGroupReplications reads all volume group replications in namespace from the output
+// directory.
+func ReadVolumeGroupReplications(
+	reader gathering.OutputReader,
+	namespace string,
+) ([]*VolumeReplication, error) {
+	names, err := reader.ListResources(namespace, volumeGroupReplicationResource)
+	if err != nil {
+		return nil, err
+	}
+	var vgrs []*VolumeReplication
+	for _, name := range names {
+		data, err := reader.ReadResource(namespace, volumeGroupReplicationResource, name)
+		if err != nil {
+			return nil, err
+		}
+		vgr := &VolumeReplication{}
+		if err := yaml.Unmarshal(data, vgr); err != nil {
+			return nil, err
+		}
+		vgrs = append(vgrs, vgr)
+	}
+	return vgrs, nil
+}
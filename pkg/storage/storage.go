@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+)
+
+const (
+	// StorageIDLabel is the label with the storage ID on storage classes.
+	// TODO: consume from ramen: https://github.com/RamenDR/ramen/issues/2515
+	StorageIDLabel = "ramendr.openshift.io/storageid"
+
+	// ReplicationIDLabel is the label with the replication ID on volume replication classes and
+	// volume group replication classes.
+	// TODO: consume from ramen: https://github.com/RamenDR/ramen/issues/2515
+	ReplicationIDLabel = "ramendr.openshift.io/replicationid"
+
+	// SchedulingIntervalParameter is the replication class parameter with the replication
+	// schedule.
+	SchedulingIntervalParameter = "schedulingInterval"
+
+	// VolumeReplicationClassKind and VolumeGroupReplicationClassKind are the kinds of the csi-addons
+	// replication classes.
+	VolumeReplicationClassKind      = "VolumeReplicationClass"
+	VolumeGroupReplicationClassKind = "VolumeGroupReplicationClass"
+
+	storageClassResource        = storagev1.GroupName + "/storageclasses"
+	volumeSnapshotClassResource = "snapshot.storage.k8s.io/volumesnapshotclasses"
+
+	// We don't depend on csi-addons apis, the resources are parsed to ReplicationClass.
+	replicationGroup                    = "replication.storage.openshift.io"
+	volumeReplicationClassResource      = replicationGroup + "/volumereplicationclasses"
+	volumeGroupReplicationClassResource = replicationGroup + "/volumegroupreplicationclasses"
+)
+
+// ReplicationClass is the part of VolumeReplicationClass or VolumeGroupReplicationClass used by
+// ramen to select a replication class.
+type ReplicationClass struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              ReplicationClassSpec `json:"spec"`
+}
+
+// ReplicationClassSpec is the spec of a VolumeReplicationClass or VolumeGroupReplicationClass.
+type ReplicationClassSpec struct {
+	Provisioner string            `json:"provisioner"`
+	Parameters  map[string]string `json:"parameters,omitempty"`
+}
+
+// ListStorageClasses lists storage classes from the output directory.
+func ListStorageClasses(reader gathering.OutputReader) ([]string, error) {
+	return reader.ListResources("", storageClassResource)
+}
+
+// ListVolumeSnapshotClasses lists volume snapshot classes from the output directory.
+func ListVolumeSnapshotClasses(reader gathering.OutputReader) ([]string, error) {
+	return reader.ListResources("", volumeSnapshotClassResource)
+}
+
+// ReadStorageClass reads a storage class from the output directory.
+func ReadStorageClass(
+	reader gathering.OutputReader,
+	name string,
+) (*storagev1.StorageClass, error) {
+	data, err := reader.ReadResource("", storageClassResource, name)
+	if err != nil {
+		return nil, err
+	}
+	storageClass := &storagev1.StorageClass{}
+	if err := yaml.Unmarshal(data, storageClass); err != nil {
+		return nil, err
+	}
+	return storageClass, nil
+}
+
+// ReadReplicationClasses reads all volume replication classes, or volume group replication classes
+// if grouping is true, from the output directory.
+func ReadReplicationClasses(
+	reader gathering.OutputReader,
+	grouping bool,
+) ([]*ReplicationClass, error) {
+	resource := volumeReplicationClassResource
+	if grouping {
+		resource = volumeGroupReplicationClassResource
+	}
+	names, err := reader.ListResources("", resource)
+	if err != nil {
+		return nil, err
+	}
+	var classes []*ReplicationClass
+	for _, name := range names {
+		data, err := reader.ReadResource("", resource, name)
+		if err != nil {
+			return nil, err
+		}
+		class := &ReplicationClass{}
+		if err := yaml.Unmarshal(data, class); err != nil {
+			return nil, err
+		}
+		classes = append(classes, class)
+	}
+	return classes, nil
+}
+
+// ReplicationClassKind returns the kind of replication class used by ramen for a peer class.
+func ReplicationClassKind(grouping bool) string {
+	if grouping {
+		return VolumeGroupReplicationClassKind
+	}
+	return VolumeReplicationClassKind
+}
@@ -5,22 +5,29 @@ package test
 
 import (
 	"github.com/ramendr/ramenctl/pkg/command"
+	"github.com/ramendr/ramenctl/pkg/config"
 	"github.com/ramendr/ramenctl/pkg/console"
 	"github.com/ramendr/ramenctl/pkg/testing"
 )
 
 func Clean(opts command.Options) error {
-	cfg, err := readConfig(opts.ConfigFile)
+	clusters, err := config.ReadClusters(opts.ConfigFile)
 	if err != nil {
 		return console.Failed(err)
 	}
 
-	cmd, err := command.New("test-clean", cfg.Clusters, opts)
+	cmd, err := command.New("test-clean", clusters, opts)
 	if err != nil {
 		return console.Failed(err)
 	}
 	defer cmd.Close()
 
+	// Read the test config using the clusters kubeconfigs resolved by the command.
+	cfg, err := readConfig(opts.ConfigFile, cmd.Clusters())
+	if err != nil {
+		return console.Failed(err)
+	}
+
 	test := newCommand(cmd, cfg, testing.Backend{})
 	if err := test.Clean(); err != nil {
 		return console.Failed(err)
@@ -81,6 +81,10 @@ func (r *ramenContext) Env() *types.Env {
 	return r.cmd.Env()
 }
 
+func (r *ramenContext) ManagedClusters() []*types.Cluster {
+	return r.cmd.command.ManagedClusters()
+}
+
 func (r *ramenContext) Context() context.Context {
 	return r.cmd.Context()
 }
@@ -468,7 +472,7 @@ func (c *Command) namespacesToGather() []string {
 func (c *Command) s3ProfilesToGather() []*ramenapi.S3StoreProfile {
 	ctx := &ramenContext{
 		cmd: c,
-		cfg: &config.Config{Namespaces: c.config.Namespaces},
+		cfg: &config.Config{Namespaces: config.Namespaces{Namespaces: c.config.Namespaces}},
 	}
 	seen := map[string]struct{}{}
 	var profiles []*ramenapi.S3StoreProfile
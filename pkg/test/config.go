@@ -5,23 +5,89 @@ package test
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/ramendr/ramen/e2e/config"
 	"github.com/ramendr/ramen/e2e/deployers"
 	"github.com/ramendr/ramen/e2e/workloads"
+	"gopkg.in/yaml.v3"
 
 	"github.com/ramendr/ramenctl/pkg/console"
 )
 
-func readConfig(filename string) (*config.Config, error) {
+// readConfig reads the test configuration using the clusters kubeconfigs resolved by the command.
+// The ramen e2e config does not support kubeconfig contexts, so the configuration is read from a
+// temporary copy with the clusters replaced by the resolved kubeconfigs.
+func readConfig(filename string, clusters map[string]config.Cluster) (*config.Config, error) {
+	resolved, err := writeResolvedConfig(filename, clusters)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config: %w", err)
+	}
+	defer os.Remove(resolved)
+
 	options := config.Options{
 		Workloads: workloads.AvailableNames(),
 		Deployers: deployers.AvailableTypes(),
 	}
-	config, err := config.ReadConfig(filename, options)
+	config, err := config.ReadConfig(resolved, options)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read config: %w", err)
 	}
 	console.Info("Using config %q", filename)
 	return config, nil
 }
+
+// writeResolvedConfig writes a temporary copy of the configuration file with the clusters replaced
+// by clusters, and returns the path to the copy. The caller must remove the file.
+func writeResolvedConfig(filename string, clusters map[string]config.Cluster) (string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	root := &yaml.Node{}
+	if err := yaml.Unmarshal(data, root); err != nil {
+		return "", fmt.Errorf("failed to parse config %q: %w", filename, err)
+	}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 ||
+		root.Content[0].Kind != yaml.MappingNode {
+		return "", fmt.Errorf("invalid config %q", filename)
+	}
+
+	kubeconfigs := make(map[string]map[string]string, len(clusters))
+	for name, cluster := range clusters {
+		kubeconfigs[name] = map[string]string{"kubeconfig": cluster.Kubeconfig}
+	}
+	value := &yaml.Node{}
+	if err := value.Encode(kubeconfigs); err != nil {
+		return "", err
+	}
+
+	document := root.Content[0]
+	for i := 0; i+1 < len(document.Content); i += 2 {
+		// Viper keys are case insensitive.
+		if strings.EqualFold(document.Content[i].Value, "clusters") {
+			document.Content[i+1] = value
+		}
+	}
+
+	resolved, err := yaml.Marshal(root)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.CreateTemp("", "ramenctl-config-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.Write(resolved); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
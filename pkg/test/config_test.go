@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/ramendr/ramenctl/pkg/command"
+	"github.com/ramendr/ramenctl/pkg/config"
+)
+
+func TestReadConfigContexts(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("KUBECONFIG", writeTestKubeconfig(t, dir, "hub", "dr1", "dr2"))
+
+	// A config using only contexts in the default kubeconfig.
+	filename := filepath.Join(dir, "config.yaml")
+	sample := config.NewSample("ramenctl")
+	sample.HubKubeconfig, sample.HubContext = "", "hub"
+	sample.PrimaryKubeconfig, sample.PrimaryContext = "", "dr1"
+	sample.SecondaryKubeconfig, sample.SecondaryContext = "", "dr2"
+	if err := config.WriteSample(filename, sample); err != nil {
+		t.Fatal(err)
+	}
+
+	// Resolve the clusters like the test commands.
+	clusters, err := config.ReadClusters(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resolved, err := command.EnvClusters(clusters, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := readConfig(filename, resolved)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, context := range map[string]string{"hub": "hub", "c1": "dr1", "c2": "dr2"} {
+		kubeconfig := cfg.Clusters[name].Kubeconfig
+		if kubeconfig != resolved[name].Kubeconfig {
+			t.Errorf("expected cluster %q kubeconfig %q, got %q",
+				name, resolved[name].Kubeconfig, kubeconfig)
+			continue
+		}
+		loaded, err := clientcmd.LoadFromFile(kubeconfig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if loaded.CurrentContext != context {
+			t.Errorf("expected cluster %q context %q, got %q",
+				name, context, loaded.CurrentContext)
+		}
+	}
+}
+
+// writeTestKubeconfig writes a kubeconfig with a context for every name and returns its path.
+func writeTestKubeconfig(t *testing.T, dir string, names ...string) string {
+	t.Helper()
+	kubeconfig := clientcmdapi.NewConfig()
+	for _, name := range names {
+		kubeconfig.Clusters[name] = &clientcmdapi.Cluster{Server: "https://" + name + ":6443"}
+		kubeconfig.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: name + "-token"}
+		kubeconfig.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name}
+	}
+	kubeconfig.CurrentContext = names[0]
+	path := filepath.Join(dir, "kubeconfig")
+	if err := clientcmd.WriteToFile(*kubeconfig, path); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
@@ -86,5 +86,7 @@ func (b Backend) GatherS3(
 	prefixes []string,
 	outputDir string,
 ) <-chan s3.Result {
-	return s3.Gather(ctx.Context(), profiles, prefixes, outputDir, ctx.Logger())
+	// The test command gathers all objects.
+	options := s3.GatherOptions{}
+	return s3.Gather(ctx.Context(), profiles, prefixes, outputDir, options, ctx.Logger())
 }
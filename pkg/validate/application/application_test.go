@@ -10,7 +10,6 @@ import (
 	"slices"
 	"testing"
 
-	e2econfig "github.com/ramendr/ramen/e2e/config"
 	"github.com/ramendr/ramen/e2e/types"
 	"sigs.k8s.io/yaml"
 
@@ -32,12 +31,15 @@ type testSystem struct {
 	name   string
 	config *config.Config
 	env    *types.Env
+
+	// managedClusters are additional managed clusters not referenced by the application DRPolicy.
+	managedClusters []*types.Cluster
 }
 
 var testK8s = testSystem{
 	name: "k8s",
 	config: &config.Config{
-		Namespaces: e2econfig.K8sNamespaces,
+		Namespaces: config.K8sNamespaces,
 	},
 	env: &types.Env{
 		Hub: &types.Cluster{Name: "hub"},
@@ -51,7 +53,7 @@ func testCommand(
 	backend validation.Validation,
 	system testSystem,
 ) *Command {
-	cmd, err := basecmd.ForTest(CommandName, system.env, t.TempDir())
+	cmd, err := basecmd.ForTest(CommandName, system.env, t.TempDir(), system.managedClusters...)
 	if err != nil {
 		t.Fatal(err)
 	}
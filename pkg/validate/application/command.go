@@ -38,8 +38,9 @@ const CommandName = "validate-application"
 
 type Command struct {
 	*validatecmd.Command
-	opts   basecmd.ApplicationOptions
-	Report *Report
+	opts     basecmd.ApplicationOptions
+	Report   *Report
+	clusters []*e2etypes.Cluster
 }
 
 func NewCommand(
@@ -83,21 +84,25 @@ func (c *Command) validateApplication() bool {
 	console.Step("Validate application")
 	c.StartStep("validate application")
 
-	namespaces, ok := c.inspectApplication()
+	namespaces, clusters, ok := c.inspectApplication()
 	if !ok {
 		return c.FinishStep()
 	}
 
 	c.Report.Namespaces = namespaces
+	c.clusters = clusters
 
 	options := gathering.Options{
 		Namespaces: namespaces,
 		OutputDir:  c.DataDir(),
 	}
-	if !c.GatherNamespaces(options) {
+	if !c.GatherNamespaces(append([]*e2etypes.Cluster{c.Env().Hub}, clusters...), options) &&
+		!c.toleratePrimaryGatherFailure() {
 		return c.FinishStep()
 	}
 
+	c.gatherOtherClusters()
+
 	if !c.gatherS3Data() {
 		return c.FinishStep()
 	}
@@ -110,12 +115,101 @@ func (c *Command) validateApplication() bool {
 	return true
 }
 
-func (c *Command) inspectApplication() ([]string, bool) {
+// toleratePrimaryGatherFailure returns true if the only cluster we failed to gather data from is
+// the primary cluster, and we check readiness for failover. When failing over from a failed
+// primary cluster the primary cluster is expected to be unreachable, so we validate the
+// application using the data gathered from the hub and the secondary cluster.
+func (c *Command) toleratePrimaryGatherFailure() bool {
+	if c.opts.ReadinessAction != ReadinessFailover || c.Current.Status != report.Failed {
+		return false
+	}
+	if len(c.GatherFailed) != 1 || c.GatherFailed[0] == c.Env().Hub.Name {
+		return false
+	}
+
+	reader := c.OutputReader(c.Env().Hub.Name)
+	drpc, err := ramen.ReadDRPC(reader, c.opts.DRPCName, c.opts.DRPCNamespace)
+	if err != nil {
+		c.Logger().Warnf("Failed to read drpc \"%s/%s\": %s",
+			c.opts.DRPCNamespace, c.opts.DRPCName, err)
+		return false
+	}
+	primary, err := ramen.PrimaryCluster(c, drpc)
+	if err != nil {
+		c.Logger().Warnf("Failed to find primary cluster: %s", err)
+		return false
+	}
+	if c.GatherFailed[0] != primary.Name {
+		return false
+	}
+
+	console.Info("Validating failover from failed primary cluster %q", primary.Name)
+	c.Logger().Warnf("Ignoring failure to gather data from primary cluster %q", primary.Name)
+	c.Current.Status = report.Passed
+	c.Current.Err = ""
+	return true
+}
+
+// gatherOtherClusters gathers the application VRG namespace from the managed clusters not
+// referenced by the application DRPolicy, so we can detect stale VRGs left on these clusters. This
+// is best effort; failing to gather data from these clusters is logged as a warning and does not
+// fail the validation or modify the gather results of the application clusters.
+func (c *Command) gatherOtherClusters() {
+	log := c.Logger()
+
+	var others []*e2etypes.Cluster
+	for _, cluster := range c.ManagedClusters() {
+		if !slices.ContainsFunc(c.clusters, func(other *e2etypes.Cluster) bool {
+			return other.Name == cluster.Name
+		}) {
+			others = append(others, cluster)
+		}
+	}
+	if len(others) == 0 {
+		return
+	}
+
+	reader := c.OutputReader(c.Env().Hub.Name)
+	drpc, err := ramen.ReadDRPC(reader, c.opts.DRPCName, c.opts.DRPCNamespace)
+	if err != nil {
+		// Reported when validating the gathered data.
+		log.Warnf("Failed to read drpc \"%s/%s\": %s",
+			c.opts.DRPCNamespace, c.opts.DRPCName, err)
+		return
+	}
+
+	options := gathering.Options{
+		Namespaces: []string{ramen.VRGNamespace(drpc)},
+		OutputDir:  c.DataDir(),
+	}
+	log.Infof("Gathering from other clusters %q with options %+v",
+		logging.ClusterNames(others), options)
+
+	for r := range c.Backend.Gather(c, others, options) {
+		step := &report.Step{Name: fmt.Sprintf("gather %q", r.Name), Duration: r.Duration}
+		if r.Err != nil {
+			console.Info("Skipped data from cluster %q not referenced by the DRPolicy", r.Name)
+			log.Warnf("Failed to gather data from cluster %q: %s", r.Name, r.Err)
+			step.Status = report.Skipped
+			step.Err = fmt.Sprintf("Failed to gather data from cluster %q", r.Name)
+		} else {
+			console.Pass("Gathered data from cluster %q", r.Name)
+			step.Status = report.Passed
+		}
+		c.Current.AddStep(step)
+	}
+}
+
+func (c *Command) inspectApplication() ([]string, []*e2etypes.Cluster, bool) {
 	start := time.Now()
 	step := &report.Step{Name: "inspect application"}
 	c.Logger().Infof("Step %q started", step.Name)
 
-	namespaces, err := c.namespacesToGather()
+	var namespaces []string
+	clusters, err := c.clustersToGather()
+	if err == nil {
+		namespaces, err = c.namespacesToGather(clusters)
+	}
 	if err != nil {
 		step.Duration = time.Since(start).Seconds()
 		if errors.Is(err, context.Canceled) {
@@ -133,7 +227,7 @@ func (c *Command) inspectApplication() ([]string, bool) {
 		c.Logger().Errorf("Step %q %s: %s", c.Current.Name, step.Status, err)
 		c.Current.AddStep(step)
 
-		return nil, false
+		return nil, nil, false
 	}
 
 	step.Duration = time.Since(start).Seconds()
@@ -143,7 +237,7 @@ func (c *Command) inspectApplication() ([]string, bool) {
 	console.Pass("Inspected application")
 	c.Logger().Infof("Step %q passed", step.Name)
 
-	return namespaces, true
+	return namespaces, clusters, true
 }
 
 // gatherS3Data inspects application S3 profiles and gathers data. It returns false only if the
@@ -201,7 +295,7 @@ func (c *Command) gatherS3Profiles(profiles []*s3.Profile, prefix string) bool {
 		logging.ProfileNames(profiles), prefix)
 
 	var failedProfiles []string
-	for r := range c.Backend.GatherS3(c, profiles, []string{prefix}, outputDir) {
+	for r := range c.Backend.GatherS3(c, profiles, []string{prefix}, outputDir, c.opts.S3) {
 		// Store the s3 gather result for validation.
 		c.S3Results = append(c.S3Results, r)
 
@@ -248,11 +342,30 @@ func (c *Command) gatherS3Profiles(profiles []*s3.Profile, prefix string) bool {
 	}
 }
 
-func (c *Command) namespacesToGather() ([]string, error) {
-	set := map[string]struct{}{
-		// Gather ramen namespaces to get ramen hub and dr-cluster logs and related resources.
-		c.Config().Namespaces.RamenHubNamespace:       {},
-		c.Config().Namespaces.RamenDRClusterNamespace: {},
+// clustersToGather returns the managed clusters referenced by the application DRPolicy.
+func (c *Command) clustersToGather() ([]*e2etypes.Cluster, error) {
+	names, err := c.Backend.ApplicationClusters(c, c.opts.DRPCName, c.opts.DRPCNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var clusters []*e2etypes.Cluster
+	for _, name := range names {
+		cluster, err := ramen.ManagedCluster(c, name)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters, nil
+}
+
+func (c *Command) namespacesToGather(clusters []*e2etypes.Cluster) ([]string, error) {
+	// Gather ramen namespaces to get ramen hub and dr-cluster logs and related resources.
+	set := map[string]struct{}{}
+	for _, ns := range ramen.OperatorNamespaces(c.Config()) {
+		set[ns] = struct{}{}
 	}
 
 	appNamespaces, err := c.Backend.ApplicationNamespaces(c, c.opts.DRPCName, c.opts.DRPCNamespace)
@@ -264,6 +377,11 @@ func (c *Command) namespacesToGather() ([]string, error) {
 		set[ns] = struct{}{}
 	}
 
+	// Gather the managed cluster namespaces on the hub to get the application ManifestWorks.
+	for _, cluster := range clusters {
+		set[cluster.Name] = struct{}{}
+	}
+
 	return slices.Sorted(maps.Keys(set)), nil
 }
 
@@ -341,8 +459,69 @@ func (c *Command) validateGatheredData() bool {
 		return false
 	}
 
+	placement, err := c.validatedPlacement(drpc, s.PrimaryCluster.Name)
+	if err != nil {
+		step.Status = report.Failed
+		step.Err = "Failed to validate placement"
+		msg := "Failed to validate placement"
+		console.Error(msg)
+		log.Errorf("%s: %s", msg, err)
+		return false
+	}
+	s.Hub.Placement = placement
+
+	roles, err := c.vrgRoles(drpc)
+	if err != nil {
+		step.Status = report.Failed
+		step.Err = "Failed to validate vrg roles"
+		msg := "Failed to validate vrg roles"
+		console.Error(msg)
+		log.Errorf("%s: %s", msg, err)
+		return false
+	}
+	s.VRGRoles = c.validatedVRGRoles(drpc, roles, s.PrimaryCluster.Name, s.SecondaryCluster.Name)
+
+	s.KubeObjectProtection, err = c.validatedKubeObjectProtection(drpc)
+	if err != nil {
+		step.Status = report.Failed
+		step.Err = "Failed to validate kube object protection"
+		msg := "Failed to validate kube object protection"
+		console.Error(msg)
+		log.Errorf("%s: %s", msg, err)
+		return false
+	}
+
 	c.validateS3Status(&s.S3)
 
+	if c.opts.ReadinessAction != "" {
+		readiness, err := c.validatedReadiness(s)
+		if err != nil {
+			step.Status = report.Failed
+			step.Err = "Failed to validate readiness"
+			msg := "Failed to validate readiness"
+			console.Error(msg)
+			log.Errorf("%s: %s", msg, err)
+			return false
+		}
+		c.Report.Readiness = readiness
+
+		// The readiness verdict decides the result. Issues that do not block the action are
+		// reported in the summary for information.
+		if !readiness.Ready {
+			step.Status = report.Failed
+			step.Err = fmt.Sprintf("Application is not ready for %s", readiness.Action)
+			console.Error("Application is not ready for %s (%d blocking reasons)",
+				readiness.Action, len(readiness.Blocking))
+			log.Errorf("%s: %s", step.Err, strings.Join(readiness.Blocking, "; "))
+			return false
+		}
+		step.Status = report.Passed
+		console.Pass("Application is ready for %s", readiness.Action)
+		log.Infof("Application is ready for %s (%s)",
+			readiness.Action, summary.String(c.Report.Summary))
+		return true
+	}
+
 	if summary.HasIssues(c.Report.Summary) {
 		step.Status = report.Failed
 		step.Err = fmt.Sprintf("Validation failed (%s)", summary.String(c.Report.Summary))
@@ -380,7 +559,25 @@ func (c *Command) validatePrimaryCluster(
 		return fmt.Errorf("failed to find primary cluster: %w", err)
 	}
 	s.Name = cluster.Name
-	return c.validateVRG(&s.VRG, cluster, drpc, ramenapi.PrimaryState)
+	if err := c.validateVRG(&s.VRG, cluster, drpc, ramenapi.PrimaryState); err != nil {
+		return err
+	}
+	// Protected PVCs are reported only by the primary VRG, so we validate the replication
+	// resources on both clusters here.
+	secondaryCluster, err := ramen.SecondaryCluster(c, drpc)
+	if err != nil {
+		return fmt.Errorf("failed to find secondary cluster: %w", err)
+	}
+	if err := c.validateReplicationResources(&s.VRG, cluster, secondaryCluster); err != nil {
+		return err
+	}
+	s.ManifestWorks, err = c.validatedManifestWorks(cluster, drpc)
+	if err != nil {
+		return err
+	}
+	// PVCs are only validated on the primary cluster, where the application is running.
+	s.PVCs, err = c.validatedPVCs(cluster, drpc)
+	return err
 }
 
 func (c *Command) validateSecondaryCluster(
@@ -392,7 +589,11 @@ func (c *Command) validateSecondaryCluster(
 		return fmt.Errorf("failed to find secondary cluster: %w", err)
 	}
 	s.Name = cluster.Name
-	return c.validateVRG(&s.VRG, cluster, drpc, ramenapi.SecondaryState)
+	if err := c.validateVRG(&s.VRG, cluster, drpc, ramenapi.SecondaryState); err != nil {
+		return err
+	}
+	s.ManifestWorks, err = c.validatedManifestWorks(cluster, drpc)
+	return err
 }
 
 func (c *Command) validateS3Status(s *report.ApplicationS3Status) {
@@ -422,7 +623,8 @@ func (c *Command) validatedS3Profile(
 	result s3.Result,
 ) report.ApplicationS3ProfileStatus {
 	profileStatus := report.ApplicationS3ProfileStatus{
-		Name: result.ProfileName,
+		Name:      result.ProfileName,
+		Transport: report.NewS3TransportSummary(c.Config().S3.Transport(result.ProfileName)),
 	}
 
 	if result.Err != nil {
@@ -433,6 +635,16 @@ func (c *Command) validatedS3Profile(
 			},
 			Value: false,
 		}
+	} else if result.Objects == 0 {
+		// The prefix may be empty before the first upload, so this is not a problem unless we
+		// check readiness for failover or relocate.
+		profileStatus.Gathered = report.ValidatedBool{
+			Validated: report.Validated{
+				State:       report.Warning,
+				Description: "No objects found for the application",
+			},
+			Value: false,
+		}
 	} else {
 		profileStatus.Gathered = report.ValidatedBool{
 			Validated: report.Validated{
@@ -462,12 +674,17 @@ func (c *Command) validateDRPC(
 
 	s.Deleted = c.ValidatedDeleted(drpc)
 	s.DRPolicy = drpc.Spec.DRPolicyRef.Name
-	s.SchedulingInterval = c.validatedDRPCSchedulingInterval(drpc)
-	s.LastGroupSyncTime = c.validateLastGroupSyncTime(
-		drpc.Status.LastGroupSyncTime, s.ClusterTime, s.SchedulingInterval, true)
+	s.Mode = c.drpcMode(drpc)
+	s.SchedulingInterval = c.validatedDRPCSchedulingInterval(drpc, s.Mode)
+	// Metro DR replicates synchronously, so there is no replication lag to validate.
+	if s.Mode != report.MetroDR {
+		s.LastGroupSyncTime = c.validateLastGroupSyncTime(
+			drpc.Status.LastGroupSyncTime, s.ClusterTime, s.SchedulingInterval, true)
+	}
 	s.Action = c.validatedDRPCAction(string(drpc.Spec.Action))
 	s.Phase = c.validatedDRPCPhase(drpc)
 	s.Progression = c.validatedDRPCProgression(drpc)
+	s.Progress = c.validatedDRPCProgress(drpc, s.ClusterTime)
 	s.Conditions = c.ValidatedConditions(drpc, drpc.Status.Conditions)
 }
 
@@ -509,9 +726,11 @@ func (c *Command) validateVRG(
 		vrg,
 		&c.Report.ApplicationStatus.Hub.DRPC,
 	)
-	s.LastGroupSyncTime = c.validateLastGroupSyncTime(
-		vrg.Status.LastGroupSyncTime, s.ClusterTime, s.SchedulingInterval,
-		stableState == ramenapi.PrimaryState)
+	if c.Report.ApplicationStatus.Hub.DRPC.Mode != report.MetroDR {
+		s.LastGroupSyncTime = c.validateLastGroupSyncTime(
+			vrg.Status.LastGroupSyncTime, s.ClusterTime, s.SchedulingInterval,
+			stableState == ramenapi.PrimaryState)
+	}
 	s.Conditions = c.validatedVRGConditions(vrg)
 	s.ProtectedPVCs = c.validatedProtectedPVCs(cluster, vrg)
 	s.PVCGroups = c.pvcGroups(vrg)
@@ -549,10 +768,11 @@ func (c *Command) validatedDRPCProgression(
 ) report.ValidatedString {
 	validated := report.ValidatedString{Value: string(drpc.Status.Progression)}
 
-	// We expect a stable progression (Completed). An application should not be in unstable state
-	// for long time, so it we see unstable progression it requires investigation.
+	// We expect a stable progression (Completed). A non-stable progression is expected while an
+	// action is in progress, so it is reported as a warning. A progression which does not change
+	// for too long is reported as stuck by validatedDRPCProgress.
 	if drpc.Status.Progression != ramenapi.ProgressionCompleted {
-		validated.State = report.Problem
+		validated.State = report.Warning
 		validated.Description = fmt.Sprintf(
 			"Waiting for progression %q",
 			ramenapi.ProgressionCompleted,
@@ -565,6 +785,68 @@ func (c *Command) validatedDRPCProgression(
 	return validated
 }
 
+// validatedDRPCProgress reports the time since the current action started and since the last
+// change in the DRPC status, and reports a DRPC in a non-stable progression for longer than the
+// stuck progression timeout. Returns nil if the progression is stable. If the elapsed time is
+// unknown, the progression cannot be checked and a warning is reported.
+func (c *Command) validatedDRPCProgress(
+	drpc *ramenapi.DRPlacementControl,
+	clusterTime *stdtime.Time,
+) *report.DRPCProgressSummary {
+	if drpc.Status.Progression == ramenapi.ProgressionCompleted {
+		return nil
+	}
+
+	s := &report.DRPCProgressSummary{}
+
+	// metav1.Time.UnmarshalJSON converts timestamps to local time. We convert to UTC
+	// for consistency with other timestamps in YAML and HTML reports.
+	if drpc.Status.ActionStartTime != nil {
+		t := drpc.Status.ActionStartTime.UTC()
+		s.ActionStartTime = &t
+		if clusterTime != nil {
+			s.PhaseElapsed = clusterTime.Sub(t).Round(stdtime.Second).String()
+		}
+	}
+
+	// The progression start time is not recorded in the DRPC, so we use the latest change in the
+	// DRPC status as the start of the current progression.
+	progressionStart := s.ActionStartTime
+	for i := range drpc.Status.Conditions {
+		t := drpc.Status.Conditions[i].LastTransitionTime.UTC()
+		if progressionStart == nil || t.After(*progressionStart) {
+			progressionStart = &t
+		}
+	}
+
+	switch {
+	case clusterTime == nil:
+		s.ProgressionElapsed.State = report.Warning
+		s.ProgressionElapsed.Description = fmt.Sprintf(
+			"Cannot check if progression %q is stuck: cluster time is unknown",
+			drpc.Status.Progression)
+	case progressionStart == nil:
+		s.ProgressionElapsed.State = report.Warning
+		s.ProgressionElapsed.Description = fmt.Sprintf(
+			"Cannot check if progression %q is stuck: progression start time is unknown",
+			drpc.Status.Progression)
+	default:
+		elapsed := clusterTime.Sub(*progressionStart).Round(stdtime.Second)
+		timeout := c.Config().Validation.StuckProgression()
+		s.ProgressionElapsed.Value = elapsed
+		if elapsed > timeout {
+			s.ProgressionElapsed.State = report.Problem
+			s.ProgressionElapsed.Description = fmt.Sprintf(
+				"Progression %q is stuck for more than %s", drpc.Status.Progression, timeout)
+		} else {
+			s.ProgressionElapsed.State = report.OK
+		}
+	}
+
+	summary.AddValidation(c.Report.Summary, &s.ProgressionElapsed)
+	return s
+}
+
 func (c *Command) validatedVRGState(
 	vrg *ramenapi.VolumeReplicationGroup,
 	stableState ramenapi.State,
@@ -613,8 +895,32 @@ func (c *Command) validatedDRPCAction(action string) report.ValidatedString {
 	return validated
 }
 
+// drpcMode returns the DR mode of the DRPC DRPolicy, or an empty mode if the DRPolicy cannot be
+// read. Failure to read the DRPolicy is reported when validating the scheduling interval.
+func (c *Command) drpcMode(drpc *ramenapi.DRPlacementControl) report.DRMode {
+	log := c.Logger()
+	reader := c.OutputReader(c.Env().Hub.Name)
+
+	drPolicy, err := ramen.ReadDRPolicy(reader, drpc.Spec.DRPolicyRef.Name)
+	if err != nil {
+		return ""
+	}
+
+	metro, err := ramen.IsMetroDRPolicy(reader, drPolicy)
+	if err != nil {
+		log.Warnf("Failed to inspect drpolicy %q: %s", drPolicy.Name, err)
+		return ""
+	}
+
+	if metro {
+		return report.MetroDR
+	}
+	return report.RegionalDR
+}
+
 func (c *Command) validatedDRPCSchedulingInterval(
 	drpc *ramenapi.DRPlacementControl,
+	mode report.DRMode,
 ) report.ValidatedDuration {
 	log := c.Logger()
 	reader := c.OutputReader(c.Env().Hub.Name)
@@ -627,6 +933,11 @@ func (c *Command) validatedDRPCSchedulingInterval(
 			fmt.Sprintf("Could not read drpolicy %q", drpc.Spec.DRPolicyRef.Name))
 	}
 
+	// Metro DR does not use a scheduling interval.
+	if mode == report.MetroDR {
+		return report.ValidatedDuration{}
+	}
+
 	if drPolicy.Spec.SchedulingInterval == "" {
 		return c.validatedSchedulingInterval(0,
 			fmt.Sprintf("Missing scheduling interval in drpolicy %q", drPolicy.Name))
@@ -702,11 +1013,11 @@ func (c *Command) validateLastGroupSyncTime(
 ) report.ValidatedTime {
 	if lastGroupSyncTime == nil {
 		if primary {
-			return c.validatedLastGroupSyncTime(nil, report.Warning,
+			return c.validatedTime(nil, report.Warning,
 				"Waiting for first volume synchronization")
 		}
 
-		return c.validatedLastGroupSyncTime(nil, report.OK, "")
+		return c.validatedTime(nil, report.OK, "")
 	}
 
 	// metav1.Time.UnmarshalJSON converts timestamps to local time. We convert to UTC
@@ -725,19 +1036,21 @@ func (c *Command) validateLastGroupSyncTime(
 	intervals := float64(clusterTime.Sub(t)) / float64(schedulingInterval.Value)
 
 	if intervals >= 3 {
-		return c.validatedLastGroupSyncTime(&t, report.Problem,
+		return c.validatedTime(&t, report.Problem,
 			"Replication is exceeding 3x the scheduling interval")
 	}
 
 	if intervals > 2 {
-		return c.validatedLastGroupSyncTime(&t, report.Warning,
+		return c.validatedTime(&t, report.Warning,
 			"Replication is exceeding 2x the scheduling interval")
 	}
 
-	return c.validatedLastGroupSyncTime(&t, report.OK, "")
+	return c.validatedTime(&t, report.OK, "")
 }
 
-func (c *Command) validatedLastGroupSyncTime(
+// validatedTime returns a validated time with state and description. The validation is added to
+// the summary unless state is empty.
+func (c *Command) validatedTime(
 	value *stdtime.Time,
 	state report.ValidationState,
 	description string,
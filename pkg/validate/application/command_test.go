@@ -5,8 +5,15 @@ package application
 
 import (
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
 	"testing"
 
+	"github.com/ramendr/ramen/e2e/types"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
 	"github.com/ramendr/ramenctl/pkg/helpers"
 	"github.com/ramendr/ramenctl/pkg/report"
 	"github.com/ramendr/ramenctl/pkg/sets"
@@ -32,6 +39,8 @@ var (
 		testK8s.config.Namespaces.RamenDRClusterNamespace,
 		drpcNamespace,
 		applicationNamespace,
+		"dr1",
+		"dr2",
 	})
 
 	// Application mock instances.
@@ -48,11 +57,40 @@ var (
 		},
 	}
 
+	unknownApplicationCluster = &helpers.ValidationMock{
+		ApplicationClustersFunc: func(validation.Context, string, string) ([]string, error) {
+			return []string{"dr1", "dr3"}, nil
+		},
+	}
+
+	applicationClustersMock = &helpers.ValidationMock{
+		ApplicationNamespacesFunc: applicationMock.ApplicationNamespaces,
+		ApplicationClustersFunc: func(validation.Context, string, string) ([]string, error) {
+			return []string{"dr1", "dr2"}, nil
+		},
+	}
+
 	gatherDataFailed = &helpers.ValidationMock{
 		ApplicationNamespacesFunc: applicationMock.ApplicationNamespaces,
 		GatherFunc:                helpers.GatherDataFailed,
 	}
 
+	gatherPrimaryFailed = &helpers.ValidationMock{
+		ApplicationNamespacesFunc: applicationMock.ApplicationNamespaces,
+		GatherFunc:                gatherClusterFailed("dr1"),
+	}
+
+	gatherOtherClusterFailed = &helpers.ValidationMock{
+		ApplicationNamespacesFunc: applicationMock.ApplicationNamespaces,
+		ApplicationClustersFunc:   applicationClustersMock.ApplicationClusters,
+		GatherFunc:                gatherClusterFailed("dr3"),
+	}
+
+	gatherSecondaryFailed = &helpers.ValidationMock{
+		ApplicationNamespacesFunc: applicationMock.ApplicationNamespaces,
+		GatherFunc:                gatherClusterFailed("dr2"),
+	}
+
 	inspectS3ProfilesCanceled = &helpers.ValidationMock{
 		ApplicationNamespacesFunc: applicationMock.ApplicationNamespaces,
 		GetSecretFunc:             helpers.GetSecretCanceled.GetSecret,
@@ -120,7 +158,75 @@ func TestValidateApplicationPassed(t *testing.T) {
 
 	checkApplicationStatus(t, validate.Report, expectedStatus)
 
-	checkSummary(t, validate.Report, report.Summary{summary.OK: 30})
+	checkSummary(t, validate.Report, report.Summary{summary.OK: 50})
+}
+
+func TestValidateApplicationStaleVRGOnOtherCluster(t *testing.T) {
+	system := testK8s
+	system.managedClusters = []*types.Cluster{{Name: "dr3"}}
+	validate := testCommand(t, applicationClustersMock, system)
+	addGatheredDataWithout(t, validate, "")
+
+	// Simulate a stale vrg left on a managed cluster not referenced by the DRPolicy.
+	vrgDir := filepath.Join("namespaces", applicationNamespace, "ramendr.openshift.io",
+		"volumereplicationgroups")
+	vrgFile := filepath.Join(vrgDir, drpcName+".yaml")
+	data, err := os.ReadFile(filepath.Join(validate.DataDir(), "dr2", vrgFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dr3 := filepath.Join(validate.DataDir(), "dr3")
+	if err := os.MkdirAll(filepath.Join(dr3, vrgDir), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dr3, vrgFile), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// The stale vrg is an issue, failing the validation.
+	if err := validate.Run(); err == nil {
+		dumpCommandLog(t, validate)
+		t.Fatal("command did not fail")
+	}
+	checkReport(t, validate, report.Failed)
+
+	items := validate.Report.Steps[1].Items
+	if !slices.ContainsFunc(items, func(item *report.Step) bool {
+		return item.Name == "gather \"dr3\"" && item.Status == report.Passed
+	}) {
+		t.Fatalf("cluster dr3 not gathered: %+v", items)
+	}
+
+	roles := validate.Report.ApplicationStatus.VRGRoles
+	if roles == nil || roles.State != report.Problem ||
+		roles.Description != "Unexpected vrg on clusters dr3" {
+		t.Fatalf("stale vrg on cluster dr3 not reported: %+v", roles)
+	}
+}
+
+func TestValidateApplicationOtherClusterGatherFailed(t *testing.T) {
+	system := testK8s
+	system.managedClusters = []*types.Cluster{{Name: "dr3"}}
+	validate := testCommand(t, gatherOtherClusterFailed, system)
+	addGatheredDataWithout(t, validate, "")
+
+	// Failing to gather a cluster not referenced by the DRPolicy does not fail the validation.
+	if err := validate.Run(); err != nil {
+		dumpCommandLog(t, validate)
+		t.Fatal(err)
+	}
+	checkReport(t, validate, report.Passed)
+	checkError(t, validate.Report, "")
+
+	items := validate.Report.Steps[1].Items
+	if !slices.ContainsFunc(items, func(item *report.Step) bool {
+		return item.Name == "gather \"dr3\"" && item.Status == report.Skipped
+	}) {
+		t.Fatalf("cluster dr3 gather failure not reported: %+v", items)
+	}
+	if len(validate.GatherFailed) != 0 {
+		t.Fatalf("unexpected failed clusters %q", validate.GatherFailed)
+	}
 }
 
 func TestValidateApplicationValidateFailed(t *testing.T) {
@@ -203,6 +309,28 @@ func TestValidateApplicationInspectApplicationFailed(t *testing.T) {
 	checkSummary(t, validate.Report, report.Summary{})
 }
 
+func TestValidateApplicationInspectApplicationUnknownCluster(t *testing.T) {
+	validate := testCommand(t, unknownApplicationCluster, testK8s)
+	if err := validate.Run(); err == nil {
+		dumpCommandLog(t, validate)
+		t.Fatal("command did not fail")
+	}
+	checkReport(t, validate, report.Failed)
+	checkError(t, validate.Report,
+		`Failed to inspect application "appset-deploy-rbd" in namespace "argocd"`)
+	checkNamespaces(t, validate.Report, nil)
+
+	// The DRPolicy references a cluster missing in the configuration, so we skip the gather step.
+	items := []*report.Step{
+		{
+			Name:   "inspect application",
+			Status: report.Failed,
+			Err:    `Failed to inspect application "appset-deploy-rbd" in namespace "argocd"`,
+		},
+	}
+	checkItems(t, validate.Report.Steps[1], items)
+}
+
 func TestValidateApplicationInspectApplicationCanceled(t *testing.T) {
 	validate := testCommand(t, inspectApplicationCanceled, testK8s)
 	if err := validate.Run(); err == nil {
@@ -277,6 +405,79 @@ func TestValidateApplicationGatherClusterFailed(t *testing.T) {
 	checkSummary(t, validate.Report, report.Summary{})
 }
 
+func TestValidateApplicationFailoverPrimaryGatherFailed(t *testing.T) {
+	validate := testCommand(t, gatherPrimaryFailed, testK8s)
+	validate.opts.ReadinessAction = ReadinessFailover
+	addGatheredDataWithout(t, validate, "dr1")
+	if err := validate.Run(); err != nil {
+		dumpCommandLog(t, validate)
+		t.Fatal(err)
+	}
+	checkReport(t, validate, report.Passed)
+	checkError(t, validate.Report, "")
+	checkStep(t, validate.Report.Steps[1], &report.Step{
+		Name:   "validate application",
+		Status: report.Passed,
+	})
+
+	// When failing over from a failed primary cluster we validate the gathered data.
+	items := []*report.Step{
+		{Name: "inspect application", Status: report.Passed},
+		{Name: "gather \"hub\"", Status: report.Passed},
+		{
+			Name:   "gather \"dr1\"",
+			Status: report.Failed,
+			Err:    `Failed to gather data from cluster "dr1"`,
+		},
+		{Name: "gather \"dr2\"", Status: report.Passed},
+		{Name: "inspect S3 profiles", Status: report.Passed},
+		{Name: "gather S3 profile \"minio-on-dr1\"", Status: report.Passed},
+		{Name: "gather S3 profile \"minio-on-dr2\"", Status: report.Passed},
+		{Name: "validate data", Status: report.Passed},
+	}
+	checkItems(t, validate.Report.Steps[1], items)
+
+	// The missing primary cluster data is reported, but does not block failover.
+	readiness := validate.Report.Readiness
+	if readiness == nil || !readiness.Ready {
+		t.Fatalf("application not ready for failover: %+v", readiness)
+	}
+	if len(readiness.NonBlocking) == 0 {
+		t.Fatal("missing primary cluster data not reported")
+	}
+	if !summary.HasIssues(validate.Report.Summary) {
+		t.Fatalf("missing primary cluster data not reported in summary %v",
+			*validate.Report.Summary)
+	}
+}
+
+func TestValidateApplicationFailoverSecondaryGatherFailed(t *testing.T) {
+	validate := testCommand(t, gatherSecondaryFailed, testK8s)
+	validate.opts.ReadinessAction = ReadinessFailover
+	helpers.AddGatheredData(t, validate.DataDir(), applicationTestdata, validate.Report.Name)
+	if err := validate.Run(); err == nil {
+		dumpCommandLog(t, validate)
+		t.Fatal("command did not fail")
+	}
+	checkReport(t, validate, report.Failed)
+	checkError(t, validate.Report, "Failed to gather data from clusters dr2")
+	if validate.Report.Readiness != nil {
+		t.Fatalf("unexpected readiness %+v", validate.Report.Readiness)
+	}
+}
+
+func TestValidateApplicationRelocatePrimaryGatherFailed(t *testing.T) {
+	validate := testCommand(t, gatherPrimaryFailed, testK8s)
+	validate.opts.ReadinessAction = ReadinessRelocate
+	helpers.AddGatheredData(t, validate.DataDir(), applicationTestdata, validate.Report.Name)
+	if err := validate.Run(); err == nil {
+		dumpCommandLog(t, validate)
+		t.Fatal("command did not fail")
+	}
+	checkReport(t, validate, report.Failed)
+	checkError(t, validate.Report, "Failed to gather data from clusters dr1")
+}
+
 func TestValidateApplicationInspectS3ProfilesFailed(t *testing.T) {
 	validate := testCommand(t, applicationMock, testK8s)
 	// We don't add test data to cause inspect application s3 to fail.
@@ -408,11 +609,11 @@ func TestValidateApplicationGetSecretFailed(t *testing.T) {
 		{
 			Name:   "validate data",
 			Status: report.Failed,
-			Err:    "Validation failed (28 ok, 0 warning, 2 problem)",
+			Err:    "Validation failed (48 ok, 0 warning, 2 problem)",
 		},
 	}
 	checkItems(t, validate.Report.Steps[1], items)
-	checkSummary(t, validate.Report, report.Summary{summary.OK: 28, summary.Problem: 2})
+	checkSummary(t, validate.Report, report.Summary{summary.OK: 48, summary.Problem: 2})
 }
 
 func TestValidateApplicationGetSecretInvalid(t *testing.T) {
@@ -462,11 +663,11 @@ func TestValidateApplicationGetSecretInvalid(t *testing.T) {
 		{
 			Name:   "validate data",
 			Status: report.Failed,
-			Err:    "Validation failed (28 ok, 0 warning, 2 problem)",
+			Err:    "Validation failed (48 ok, 0 warning, 2 problem)",
 		},
 	}
 	checkItems(t, validate.Report.Steps[1], items)
-	checkSummary(t, validate.Report, report.Summary{summary.OK: 28, summary.Problem: 2})
+	checkSummary(t, validate.Report, report.Summary{summary.OK: 48, summary.Problem: 2})
 }
 
 func TestValidateApplicationGatherS3Failed(t *testing.T) {
@@ -510,14 +711,14 @@ func TestValidateApplicationGatherS3Failed(t *testing.T) {
 		{
 			Name:   "validate data",
 			Status: report.Failed,
-			Err:    "Validation failed (29 ok, 0 warning, 1 problem)",
+			Err:    "Validation failed (49 ok, 0 warning, 1 problem)",
 		},
 	}
 	checkItems(t, validate.Report.Steps[1], items)
 	checkSummary(
 		t,
 		validate.Report,
-		report.Summary{summary.OK: 29, summary.Problem: 1},
+		report.Summary{summary.OK: 49, summary.Problem: 1},
 	)
 }
 
@@ -562,3 +763,50 @@ func TestValidateApplicationGatherS3Canceled(t *testing.T) {
 	checkItems(t, validate.Report.Steps[1], items)
 	checkSummary(t, validate.Report, report.Summary{})
 }
+
+// gatherClusterFailed returns a gather function failing to gather data from the named cluster.
+func gatherClusterFailed(
+	name string,
+) func(validation.Context, []*types.Cluster, gathering.Options) <-chan gathering.Result {
+	return func(
+		ctx validation.Context,
+		clusters []*types.Cluster,
+		options gathering.Options,
+	) <-chan gathering.Result {
+		results := make(chan gathering.Result, len(clusters))
+		for _, cluster := range clusters {
+			if cluster.Name == name {
+				results <- gathering.Result{Name: cluster.Name, Err: errors.New("no data for you")}
+			} else {
+				results <- gathering.Result{Name: cluster.Name}
+			}
+		}
+		close(results)
+		return results
+	}
+}
+
+// addGatheredDataWithout adds the application test data without the named cluster data, like
+// gathering data when the cluster is not reachable.
+func addGatheredDataWithout(t *testing.T, validate *Command, name string) {
+	source, err := filepath.Abs(filepath.Join(applicationTestdata, validate.Report.Name+".data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := os.ReadDir(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(validate.DataDir(), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if entry.Name() == name {
+			continue
+		}
+		target := filepath.Join(validate.DataDir(), entry.Name())
+		if err := os.Symlink(filepath.Join(source, entry.Name()), target); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package application
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -18,6 +19,8 @@ import (
 
 	"github.com/ramendr/ramenctl/pkg/helpers"
 	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/s3"
+	"github.com/ramendr/ramenctl/pkg/storage"
 	"github.com/ramendr/ramenctl/pkg/validate/summary"
 )
 
@@ -226,7 +229,7 @@ func TestValidatedDRPCProgressionOK(t *testing.T) {
 	}
 }
 
-func TestValidatedDRPCProgressionError(t *testing.T) {
+func TestValidatedDRPCProgressionWaiting(t *testing.T) {
 	cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
 
 	progressions := []ramenapi.ProgressionStatus{
@@ -262,7 +265,7 @@ func TestValidatedDRPCProgressionError(t *testing.T) {
 			}
 			expected := report.ValidatedString{
 				Validated: report.Validated{
-					State: report.Problem,
+					State: report.Warning,
 					Description: fmt.Sprintf(
 						"Waiting for progression %q",
 						ramenapi.ProgressionCompleted,
@@ -277,7 +280,138 @@ func TestValidatedDRPCProgressionError(t *testing.T) {
 		})
 	}
 
-	expected := report.Summary{summary.Problem: len(progressions)}
+	expected := report.Summary{summary.Warning: len(progressions)}
+	if !cmd.Report.Summary.Equal(&expected) {
+		t.Fatalf("expected summary %v, got %v", expected, *cmd.Report.Summary)
+	}
+}
+
+func TestValidatedDRPCProgress(t *testing.T) {
+	cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+	clusterTime := stdtime.Date(2025, 7, 29, 17, 24, 30, 0, stdtime.UTC)
+	actionStartTime := clusterTime.Add(-40 * stdtime.Minute)
+
+	drpcAt := func(
+		progression ramenapi.ProgressionStatus,
+		transitionTime stdtime.Time,
+	) *ramenapi.DRPlacementControl {
+		return &ramenapi.DRPlacementControl{
+			Status: ramenapi.DRPlacementControlStatus{
+				Progression:     progression,
+				ActionStartTime: &metav1.Time{Time: actionStartTime},
+				Conditions: []metav1.Condition{
+					{
+						Type:               ramenapi.ConditionAvailable,
+						LastTransitionTime: metav1.Time{Time: transitionTime},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("completed", func(t *testing.T) {
+		drpc := drpcAt(ramenapi.ProgressionCompleted, clusterTime)
+		if progress := cmd.validatedDRPCProgress(drpc, &clusterTime); progress != nil {
+			t.Fatalf("expected nil progress, got %+v", progress)
+		}
+	})
+
+	t.Run("no cluster time", func(t *testing.T) {
+		drpc := drpcAt(ramenapi.ProgressionRunningFinalSync, clusterTime)
+		expected := &report.DRPCProgressSummary{
+			ActionStartTime: &actionStartTime,
+			ProgressionElapsed: report.ValidatedDuration{
+				Validated: report.Validated{
+					State: report.Warning,
+					Description: fmt.Sprintf(
+						"Cannot check if progression %q is stuck: cluster time is unknown",
+						ramenapi.ProgressionRunningFinalSync),
+				},
+			},
+		}
+		validated := cmd.validatedDRPCProgress(drpc, nil)
+		if !validated.Equal(expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		drpc := drpcAt(ramenapi.ProgressionRunningFinalSync,
+			clusterTime.Add(-10*stdtime.Minute))
+		expected := &report.DRPCProgressSummary{
+			ActionStartTime: &actionStartTime,
+			PhaseElapsed:    "40m0s",
+			ProgressionElapsed: report.ValidatedDuration{
+				Validated: report.Validated{State: report.OK},
+				Value:     10 * stdtime.Minute,
+			},
+		}
+		validated := cmd.validatedDRPCProgress(drpc, &clusterTime)
+		if !validated.Equal(expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("at timeout", func(t *testing.T) {
+		drpc := drpcAt(ramenapi.ProgressionRunningFinalSync,
+			clusterTime.Add(-30*stdtime.Minute))
+		expected := &report.DRPCProgressSummary{
+			ActionStartTime: &actionStartTime,
+			PhaseElapsed:    "40m0s",
+			ProgressionElapsed: report.ValidatedDuration{
+				Validated: report.Validated{State: report.OK},
+				Value:     30 * stdtime.Minute,
+			},
+		}
+		validated := cmd.validatedDRPCProgress(drpc, &clusterTime)
+		if !validated.Equal(expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("after timeout", func(t *testing.T) {
+		drpc := drpcAt(ramenapi.ProgressionRunningFinalSync,
+			clusterTime.Add(-31*stdtime.Minute))
+		expected := &report.DRPCProgressSummary{
+			ActionStartTime: &actionStartTime,
+			PhaseElapsed:    "40m0s",
+			ProgressionElapsed: report.ValidatedDuration{
+				Validated: report.Validated{
+					State: report.Problem,
+					Description: fmt.Sprintf("Progression %q is stuck for more than 30m0s",
+						ramenapi.ProgressionRunningFinalSync),
+				},
+				Value: 31 * stdtime.Minute,
+			},
+		}
+		validated := cmd.validatedDRPCProgress(drpc, &clusterTime)
+		if !validated.Equal(expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("stuck", func(t *testing.T) {
+		drpc := drpcAt(ramenapi.ProgressionRunningFinalSync,
+			actionStartTime.Add(-stdtime.Hour))
+		expected := &report.DRPCProgressSummary{
+			ActionStartTime: &actionStartTime,
+			PhaseElapsed:    "40m0s",
+			ProgressionElapsed: report.ValidatedDuration{
+				Validated: report.Validated{
+					State: report.Problem,
+					Description: fmt.Sprintf("Progression %q is stuck for more than 30m0s",
+						ramenapi.ProgressionRunningFinalSync),
+				},
+				Value: 40 * stdtime.Minute,
+			},
+		}
+		validated := cmd.validatedDRPCProgress(drpc, &clusterTime)
+		if !validated.Equal(expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	expected := report.Summary{summary.OK: 2, summary.Warning: 1, summary.Problem: 2}
 	if !cmd.Report.Summary.Equal(&expected) {
 		t.Fatalf("expected summary %v, got %v", expected, *cmd.Report.Summary)
 	}
@@ -444,7 +578,7 @@ func TestValidatedDRPCSchedulingInterval(t *testing.T) {
 			Validated: report.Validated{State: report.OK},
 			Value:     5 * stdtime.Minute,
 		}
-		validated := cmd.validatedDRPCSchedulingInterval(drpc)
+		validated := cmd.validatedDRPCSchedulingInterval(drpc, report.RegionalDR)
 		if validated != expected {
 			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
 		}
@@ -464,7 +598,7 @@ func TestValidatedDRPCSchedulingInterval(t *testing.T) {
 				Description: `Could not read drpolicy "no-such-policy"`,
 			},
 		}
-		validated := cmd.validatedDRPCSchedulingInterval(drpc)
+		validated := cmd.validatedDRPCSchedulingInterval(drpc, report.RegionalDR)
 		if validated != expected {
 			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
 		}
@@ -485,7 +619,23 @@ func TestValidatedDRPCSchedulingInterval(t *testing.T) {
 				Description: `Missing scheduling interval in drpolicy "dr-policy-empty"`,
 			},
 		}
-		validated := cmd.validatedDRPCSchedulingInterval(drpc)
+		validated := cmd.validatedDRPCSchedulingInterval(drpc, report.RegionalDR)
+		if validated != expected {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("metro", func(t *testing.T) {
+		cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+		writeDRPolicy(t, cmd.DataDir(), "dr-policy-metro", "")
+
+		drpc := &ramenapi.DRPlacementControl{
+			Spec: ramenapi.DRPlacementControlSpec{
+				DRPolicyRef: corev1.ObjectReference{Name: "dr-policy-metro"},
+			},
+		}
+		expected := report.ValidatedDuration{}
+		validated := cmd.validatedDRPCSchedulingInterval(drpc, report.MetroDR)
 		if validated != expected {
 			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
 		}
@@ -506,13 +656,45 @@ func TestValidatedDRPCSchedulingInterval(t *testing.T) {
 				Description: `Invalid scheduling interval in drpolicy "dr-policy-bad"`,
 			},
 		}
-		validated := cmd.validatedDRPCSchedulingInterval(drpc)
+		validated := cmd.validatedDRPCSchedulingInterval(drpc, report.RegionalDR)
 		if validated != expected {
 			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
 		}
 	})
 }
 
+func TestDRPCMode(t *testing.T) {
+	cases := []struct {
+		name               string
+		schedulingInterval string
+		regions            []string
+		expected           report.DRMode
+	}{
+		{"regional", "5m", []string{"east", "west"}, report.RegionalDR},
+		{"metro", "", []string{"east", "east"}, report.MetroDR},
+		{"drclusters not gathered", "", nil, report.RegionalDR},
+		{"missing scheduling interval", "", []string{"east", "west"}, report.RegionalDR},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			writeDRPolicy(t, cmd.DataDir(), "dr-policy", tc.schedulingInterval, "dr1", "dr2")
+			for i, region := range tc.regions {
+				writeDRCluster(t, cmd.DataDir(), fmt.Sprintf("dr%d", i+1), region)
+			}
+
+			drpc := &ramenapi.DRPlacementControl{
+				Spec: ramenapi.DRPlacementControlSpec{
+					DRPolicyRef: corev1.ObjectReference{Name: "dr-policy"},
+				},
+			}
+			if mode := cmd.drpcMode(drpc); mode != tc.expected {
+				t.Fatalf("expected mode %q, got %q", tc.expected, mode)
+			}
+		})
+	}
+}
+
 func TestValidatedVRGSchedulingInterval(t *testing.T) {
 	t.Run("ok", func(t *testing.T) {
 		cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
@@ -912,8 +1094,764 @@ func TestValidatedVRGConditions(t *testing.T) {
 	})
 }
 
+func TestValidatedPlacementDecision(t *testing.T) {
+	cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+
+	t.Run("primary cluster", func(t *testing.T) {
+		expected := report.ValidatedString{
+			Value: "dr1",
+			Validated: report.Validated{
+				State: report.OK,
+			},
+		}
+		validated := cmd.validatedPlacementDecision([]string{"dr1"}, "dr1")
+		if validated != expected {
+			t.Fatalf("expected decision %+v, got %+v", expected, validated)
+		}
+	})
+
+	t.Run("no decision", func(t *testing.T) {
+		expected := report.ValidatedString{
+			Validated: report.Validated{
+				State:       report.Problem,
+				Description: "Placement has no decision",
+			},
+		}
+		validated := cmd.validatedPlacementDecision(nil, "dr1")
+		if validated != expected {
+			t.Fatalf("expected decision %+v, got %+v", expected, validated)
+		}
+	})
+
+	mismatch := []struct {
+		name     string
+		clusters []string
+		value    string
+	}{
+		{"other cluster", []string{"dr2"}, "dr2"},
+		{"multiple clusters", []string{"dr1", "dr2"}, "dr1, dr2"},
+	}
+	for _, tc := range mismatch {
+		t.Run(tc.name, func(t *testing.T) {
+			expected := report.ValidatedString{
+				Value: tc.value,
+				Validated: report.Validated{
+					State:       report.Problem,
+					Description: "Placement decision does not match primary cluster \"dr1\"",
+				},
+			}
+			validated := cmd.validatedPlacementDecision(tc.clusters, "dr1")
+			if validated != expected {
+				t.Fatalf("expected decision %+v, got %+v", expected, validated)
+			}
+		})
+	}
+
+	t.Run("update summary", func(t *testing.T) {
+		expected := report.Summary{summary.OK: 1, summary.Problem: 3}
+		if !cmd.Report.Summary.Equal(&expected) {
+			t.Fatalf("expected summary %v, got %v", expected, *cmd.Report.Summary)
+		}
+	})
+}
+
+func TestValidatedVRGRoles(t *testing.T) {
+	cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+
+	primary := report.VRGRoleSummary{
+		Cluster:          "dr1",
+		ReplicationState: string(ramenapi.Primary),
+		State:            string(ramenapi.PrimaryState),
+	}
+	secondary := report.VRGRoleSummary{
+		Cluster:          "dr2",
+		ReplicationState: string(ramenapi.Secondary),
+		State:            string(ramenapi.SecondaryState),
+	}
+	stalePrimary := report.VRGRoleSummary{
+		Cluster:          "dr2",
+		ReplicationState: string(ramenapi.Primary),
+		State:            string(ramenapi.PrimaryState),
+	}
+	unexpected := report.VRGRoleSummary{
+		Cluster:          "dr3",
+		ReplicationState: string(ramenapi.Secondary),
+		State:            string(ramenapi.SecondaryState),
+	}
+
+	cases := []struct {
+		name        string
+		action      ramenapi.DRAction
+		phase       ramenapi.DRState
+		roles       []report.VRGRoleSummary
+		state       report.ValidationState
+		description string
+	}{
+		{
+			name:  "deployed",
+			phase: ramenapi.Deployed,
+			roles: []report.VRGRoleSummary{primary, secondary},
+			state: report.OK,
+		},
+		{
+			name:        "multiple primaries",
+			phase:       ramenapi.Deployed,
+			roles:       []report.VRGRoleSummary{primary, stalePrimary},
+			state:       report.Problem,
+			description: "Multiple primary vrgs on clusters dr1, dr2",
+		},
+		{
+			name:        "multiple primaries during failover",
+			action:      ramenapi.ActionFailover,
+			phase:       ramenapi.FailingOver,
+			roles:       []report.VRGRoleSummary{primary, stalePrimary},
+			state:       report.Warning,
+			description: "Multiple primary vrgs on clusters dr1, dr2 in phase \"FailingOver\"",
+		},
+		{
+			name:        "unexpected cluster",
+			phase:       ramenapi.Deployed,
+			roles:       []report.VRGRoleSummary{primary, secondary, unexpected},
+			state:       report.Problem,
+			description: "Unexpected vrg on clusters dr3",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			drpc := &ramenapi.DRPlacementControl{
+				Spec:   ramenapi.DRPlacementControlSpec{Action: tc.action},
+				Status: ramenapi.DRPlacementControlStatus{Phase: tc.phase},
+			}
+			expected := &report.ValidatedVRGRolesList{
+				Validated: report.Validated{
+					State:       tc.state,
+					Description: tc.description,
+				},
+				Value: tc.roles,
+			}
+			validated := cmd.validatedVRGRoles(drpc, tc.roles, "dr1", "dr2")
+			if !validated.Equal(expected) {
+				t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+			}
+		})
+	}
+
+	t.Run("update summary", func(t *testing.T) {
+		expected := report.Summary{summary.OK: 1, summary.Warning: 1, summary.Problem: 2}
+		if !cmd.Report.Summary.Equal(&expected) {
+			t.Fatalf("expected summary %v, got %v", expected, *cmd.Report.Summary)
+		}
+	})
+}
+
+func TestValidatedPVCProtected(t *testing.T) {
+	cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+
+	cases := []struct {
+		name      string
+		selected  bool
+		protected bool
+		expected  report.ValidatedBool
+	}{
+		{
+			name:      "protected",
+			selected:  true,
+			protected: true,
+			expected: report.ValidatedBool{
+				Validated: report.Validated{State: report.OK},
+				Value:     true,
+			},
+		},
+		{
+			name:     "selected but not protected",
+			selected: true,
+			expected: report.ValidatedBool{
+				Validated: report.Validated{
+					State:       report.Problem,
+					Description: "PVC is selected but not protected",
+				},
+			},
+		},
+		{
+			name: "not selected",
+			expected: report.ValidatedBool{
+				Validated: report.Validated{
+					State:       report.Warning,
+					Description: "PVC does not match the DRPC pvcSelector",
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			validated := cmd.validatedPVCProtected(tc.selected, tc.protected)
+			if validated != tc.expected {
+				t.Fatalf("expected %+v, got %+v", tc.expected, validated)
+			}
+		})
+	}
+
+	t.Run("update summary", func(t *testing.T) {
+		expected := report.Summary{summary.OK: 1, summary.Warning: 1, summary.Problem: 1}
+		if !cmd.Report.Summary.Equal(&expected) {
+			t.Fatalf("expected summary %v, got %v", expected, *cmd.Report.Summary)
+		}
+	})
+}
+
+func TestPVCReplication(t *testing.T) {
+	peerClasses := []ramenapi.PeerClass{
+		{StorageClassName: "rook-ceph-block", ReplicationID: "rook-ceph-replication-1"},
+		{StorageClassName: "rook-cephfs-fs1"},
+	}
+	cases := []struct {
+		storageClass string
+		expected     report.ReplicationType
+	}{
+		{"rook-ceph-block", report.Volrep},
+		{"rook-cephfs-fs1", report.Volsync},
+		{"standard", ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.storageClass, func(t *testing.T) {
+			replication := pvcReplication(tc.storageClass, peerClasses)
+			if replication != tc.expected {
+				t.Fatalf("expected replication %q, got %q", tc.expected, replication)
+			}
+		})
+	}
+}
+
+func TestValidatedVolumeReplicationConditions(t *testing.T) {
+	completed := metav1.Condition{
+		Type:   storage.ReplicationConditionCompleted,
+		Status: metav1.ConditionTrue,
+	}
+	degraded := metav1.Condition{
+		Type:    storage.ReplicationConditionDegraded,
+		Status:  metav1.ConditionTrue,
+		Message: "volume is degraded",
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+		expected := []report.ValidatedCondition{
+			{
+				Validated: report.Validated{State: report.OK},
+				Type:      storage.ReplicationConditionCompleted,
+			},
+		}
+		validated := cmd.validatedVolumeReplicationConditions([]metav1.Condition{completed})
+		if !slices.Equal(validated, expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("degraded", func(t *testing.T) {
+		cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+		expected := []report.ValidatedCondition{
+			{
+				Validated: report.Validated{State: report.OK},
+				Type:      storage.ReplicationConditionCompleted,
+			},
+			{
+				Validated: report.Validated{
+					State:       report.Problem,
+					Description: "volume is degraded",
+				},
+				Type: storage.ReplicationConditionDegraded,
+			},
+		}
+		conditions := []metav1.Condition{completed, degraded}
+		validated := cmd.validatedVolumeReplicationConditions(conditions)
+		if !slices.Equal(validated, expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+}
+
+func TestVolumeGroupReplicationForPVC(t *testing.T) {
+	vgr := &storage.VolumeReplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "vgr-name"},
+		Status: storage.VolumeReplicationStatus{
+			PVCRefs: []corev1.LocalObjectReference{{Name: "pvc-1"}, {Name: "pvc-2"}},
+		},
+	}
+	vgrs := []*storage.VolumeReplication{vgr}
+
+	if found := volumeGroupReplicationForPVC(vgrs, "pvc-2"); found != vgr {
+		t.Fatalf("expected vgr %q, got %v", vgr.Name, found)
+	}
+	if found := volumeGroupReplicationForPVC(vgrs, "pvc-3"); found != nil {
+		t.Fatalf("expected no vgr, got %q", found.Name)
+	}
+}
+
+func TestValidateLastCaptureTime(t *testing.T) {
+	cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+	clusterTime := stdtime.Date(2025, 7, 29, 17, 24, 30, 0, stdtime.UTC)
+	captureInterval := 5 * stdtime.Minute
+
+	t.Run("nil", func(t *testing.T) {
+		expected := report.ValidatedTime{
+			Validated: report.Validated{
+				State:       report.Warning,
+				Description: "Waiting for first kube objects capture",
+			},
+		}
+		validated := cmd.validateLastCaptureTime(nil, &clusterTime, captureInterval)
+		if !validated.Equal(&expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("no cluster time", func(t *testing.T) {
+		captureTime := metav1.NewTime(clusterTime.Add(-5 * stdtime.Minute))
+		expected := report.ValidatedTime{Value: &captureTime.Time}
+		validated := cmd.validateLastCaptureTime(&captureTime, nil, captureInterval)
+		if !validated.Equal(&expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		captureTime := metav1.NewTime(clusterTime.Add(-10 * stdtime.Minute))
+		expected := report.ValidatedTime{
+			Validated: report.Validated{State: report.OK},
+			Value:     &captureTime.Time,
+		}
+		validated := cmd.validateLastCaptureTime(&captureTime, &clusterTime, captureInterval)
+		if !validated.Equal(&expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("warning", func(t *testing.T) {
+		captureTime := metav1.NewTime(clusterTime.Add(-11 * stdtime.Minute))
+		expected := report.ValidatedTime{
+			Validated: report.Validated{
+				State:       report.Warning,
+				Description: "Kube objects capture is exceeding 2x the capture interval",
+			},
+			Value: &captureTime.Time,
+		}
+		validated := cmd.validateLastCaptureTime(&captureTime, &clusterTime, captureInterval)
+		if !validated.Equal(&expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("problem", func(t *testing.T) {
+		captureTime := metav1.NewTime(clusterTime.Add(-15 * stdtime.Minute))
+		expected := report.ValidatedTime{
+			Validated: report.Validated{
+				State:       report.Problem,
+				Description: "Kube objects capture is exceeding 3x the capture interval",
+			},
+			Value: &captureTime.Time,
+		}
+		validated := cmd.validateLastCaptureTime(&captureTime, &clusterTime, captureInterval)
+		if !validated.Equal(&expected) {
+			t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, validated))
+		}
+	})
+
+	t.Run("update summary", func(t *testing.T) {
+		expected := report.Summary{summary.OK: 1, summary.Warning: 2, summary.Problem: 1}
+		if !cmd.Report.Summary.Equal(&expected) {
+			t.Fatalf("expected summary %v, got %v", expected, *cmd.Report.Summary)
+		}
+	})
+}
+
+func TestApplicationReadiness(t *testing.T) {
+	ok := report.Validated{State: report.OK}
+	problem := func(description string) report.Validated {
+		return report.Validated{State: report.Problem, Description: description}
+	}
+	now := stdtime.Now()
+
+	readyStatus := func() *report.ApplicationStatus {
+		return &report.ApplicationStatus{
+			Hub: report.ApplicationStatusHub{
+				DRPC: report.DRPCSummary{
+					Deleted:           report.ValidatedBool{Validated: ok},
+					LastGroupSyncTime: report.ValidatedTime{Validated: ok, Value: &now},
+					Phase:             report.ValidatedString{Validated: ok},
+					Progression:       report.ValidatedString{Validated: ok},
+					Conditions: []report.ValidatedCondition{
+						{Validated: ok, Type: ramenapi.ConditionAvailable},
+						{Validated: ok, Type: ramenapi.ConditionPeerReady},
+					},
+				},
+			},
+			PrimaryCluster: report.ApplicationStatusCluster{
+				Name: "dr1",
+				VRG: report.VRGSummary{
+					Deleted: report.ValidatedBool{Validated: ok},
+					State:   report.ValidatedString{Validated: ok},
+				},
+			},
+			SecondaryCluster: report.ApplicationStatusCluster{
+				Name: "dr2",
+				VRG: report.VRGSummary{
+					Deleted: report.ValidatedBool{Validated: ok},
+					State:   report.ValidatedString{Validated: ok},
+				},
+			},
+		}
+	}
+	drCluster := func() *ramenapi.DRCluster {
+		return &ramenapi.DRCluster{ObjectMeta: metav1.ObjectMeta{Name: "dr2"}}
+	}
+	metroStatus := func() *report.ApplicationStatus {
+		s := readyStatus()
+		s.Hub.DRPC.Mode = report.MetroDR
+		s.Hub.DRPC.SchedulingInterval = report.ValidatedDuration{}
+		s.Hub.DRPC.LastGroupSyncTime = report.ValidatedTime{}
+		return s
+	}
+	primaryDRCluster := func(fence ramenapi.ClusterFenceState) func() *ramenapi.DRCluster {
+		return func() *ramenapi.DRCluster {
+			return &ramenapi.DRCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "dr1"},
+				Spec:       ramenapi.DRClusterSpec{ClusterFence: fence},
+			}
+		}
+	}
+
+	cases := []struct {
+		name             string
+		action           string
+		status           func() *report.ApplicationStatus
+		drCluster        func() *ramenapi.DRCluster
+		primaryDRCluster func() *ramenapi.DRCluster
+		blocking         []string
+		nonBlocking      []string
+	}{
+		{
+			name:      "failover ready",
+			action:    ReadinessFailover,
+			status:    readyStatus,
+			drCluster: drCluster,
+		},
+		{
+			name:      "relocate ready",
+			action:    ReadinessRelocate,
+			status:    readyStatus,
+			drCluster: drCluster,
+		},
+		{
+			name:   "drcluster not gathered",
+			action: ReadinessFailover,
+			status: readyStatus,
+			nonBlocking: []string{
+				"DRCluster \"dr2\" was not gathered, fencing and maintenance mode were not " +
+					"checked",
+			},
+		},
+		{
+			name:   "failover from failed primary",
+			action: ReadinessFailover,
+			status: func() *report.ApplicationStatus {
+				s := readyStatus()
+				s.PrimaryCluster.VRG.Deleted.Validated = problem("Resource does not exist")
+				s.Hub.DRPC.Conditions[1].Validated = problem("Peer is not ready")
+				return s
+			},
+			drCluster: drCluster,
+			nonBlocking: []string{
+				"DRPC is not peer ready",
+				"Primary VRG on cluster \"dr1\": Resource does not exist",
+			},
+		},
+		{
+			name:   "relocate from failed primary",
+			action: ReadinessRelocate,
+			status: func() *report.ApplicationStatus {
+				s := readyStatus()
+				s.PrimaryCluster.VRG.Deleted.Validated = problem("Resource does not exist")
+				s.Hub.DRPC.Conditions[1].Validated = problem("Peer is not ready")
+				return s
+			},
+			drCluster: drCluster,
+			blocking: []string{
+				"DRPC is not peer ready",
+				"Primary VRG on cluster \"dr1\": Resource does not exist",
+			},
+		},
+		{
+			name:   "progression in progress",
+			action: ReadinessRelocate,
+			status: func() *report.ApplicationStatus {
+				s := readyStatus()
+				s.Hub.DRPC.Progression = report.ValidatedString{
+					Validated: report.Validated{
+						State:       report.Warning,
+						Description: "Waiting for progression \"Completed\"",
+					},
+					Value: string(ramenapi.ProgressionCleaningUp),
+				}
+				s.Hub.DRPC.Progress = &report.DRPCProgressSummary{
+					ProgressionElapsed: report.ValidatedDuration{Validated: ok},
+				}
+				return s
+			},
+			drCluster: drCluster,
+			nonBlocking: []string{
+				"DRPC progression \"Cleaning Up\": Waiting for progression \"Completed\"",
+			},
+		},
+		{
+			name:   "progression stuck",
+			action: ReadinessRelocate,
+			status: func() *report.ApplicationStatus {
+				s := readyStatus()
+				s.Hub.DRPC.Progression = report.ValidatedString{
+					Validated: report.Validated{
+						State:       report.Warning,
+						Description: "Waiting for progression \"Completed\"",
+					},
+					Value: string(ramenapi.ProgressionCleaningUp),
+				}
+				s.Hub.DRPC.Progress = &report.DRPCProgressSummary{
+					ProgressionElapsed: report.ValidatedDuration{
+						Validated: problem(
+							"Progression \"Cleaning Up\" is stuck for more than 30m0s"),
+					},
+				}
+				return s
+			},
+			drCluster: drCluster,
+			blocking: []string{
+				"DRPC progress: Progression \"Cleaning Up\" is stuck for more than 30m0s",
+			},
+			nonBlocking: []string{
+				"DRPC progression \"Cleaning Up\": Waiting for progression \"Completed\"",
+			},
+		},
+		{
+			name:   "failover without synchronization",
+			action: ReadinessFailover,
+			status: func() *report.ApplicationStatus {
+				s := readyStatus()
+				s.Hub.DRPC.LastGroupSyncTime = report.ValidatedTime{
+					Validated: report.Validated{
+						State:       report.Warning,
+						Description: "Waiting for first volume synchronization",
+					},
+				}
+				return s
+			},
+			drCluster: drCluster,
+			blocking:  []string{"No volume synchronization completed, no data to fail over"},
+		},
+		{
+			name:   "unhealthy secondary vrg",
+			action: ReadinessFailover,
+			status: func() *report.ApplicationStatus {
+				s := readyStatus()
+				s.SecondaryCluster.VRG.Conditions = []report.ValidatedCondition{
+					{Validated: problem("Not replicating"), Type: "DataReady"},
+				}
+				return s
+			},
+			drCluster: drCluster,
+			blocking: []string{
+				"Secondary VRG on cluster \"dr2\" condition \"DataReady\": Not replicating",
+			},
+		},
+		{
+			name:   "target fenced and in maintenance",
+			action: ReadinessFailover,
+			status: readyStatus,
+			drCluster: func() *ramenapi.DRCluster {
+				d := drCluster()
+				d.Spec.ClusterFence = ramenapi.ClusterFenceStateFenced
+				d.Status.MaintenanceModes = []ramenapi.ClusterMaintenanceMode{
+					{StorageProvisioner: "rbd.csi.ceph.com"},
+				}
+				return d
+			},
+			blocking: []string{
+				"Target cluster \"dr2\" is fenced",
+				"Target cluster \"dr2\" is in maintenance mode",
+			},
+		},
+		{
+			name:   "s3 data missing",
+			action: ReadinessRelocate,
+			status: func() *report.ApplicationStatus {
+				s := readyStatus()
+				s.S3.Profiles.Value = []report.ApplicationS3ProfileStatus{
+					{
+						Name:     "minio-on-dr1",
+						Gathered: report.ValidatedBool{Validated: problem("connection refused")},
+					},
+				}
+				return s
+			},
+			drCluster: drCluster,
+			blocking:  []string{"S3 profile \"minio-on-dr1\": connection refused"},
+		},
+		{
+			name:   "s3 data not available",
+			action: ReadinessFailover,
+			status: func() *report.ApplicationStatus {
+				s := readyStatus()
+				s.S3.Profiles.Validated = problem("S3 data not available")
+				return s
+			},
+			drCluster: drCluster,
+			blocking:  []string{"S3 profiles: S3 data not available"},
+		},
+		{
+			name:   "s3 prefix empty",
+			action: ReadinessFailover,
+			status: func() *report.ApplicationStatus {
+				s := readyStatus()
+				s.S3.Profiles.Value = []report.ApplicationS3ProfileStatus{
+					{
+						Name: "minio-on-dr1",
+						Gathered: report.ValidatedBool{
+							Validated: report.Validated{
+								State:       report.Warning,
+								Description: "No objects found for the application",
+							},
+						},
+					},
+				}
+				return s
+			},
+			drCluster: drCluster,
+			blocking: []string{
+				"S3 profile \"minio-on-dr1\": No objects found for the application",
+			},
+		},
+		{
+			name:             "metro failover ready",
+			action:           ReadinessFailover,
+			status:           metroStatus,
+			drCluster:        drCluster,
+			primaryDRCluster: primaryDRCluster(ramenapi.ClusterFenceStateFenced),
+		},
+		{
+			name:             "metro failover primary not fenced",
+			action:           ReadinessFailover,
+			status:           metroStatus,
+			drCluster:        drCluster,
+			primaryDRCluster: primaryDRCluster(ramenapi.ClusterFenceStateUnfenced),
+			blocking:         []string{"Primary cluster \"dr1\" must be fenced before failover"},
+		},
+		{
+			name:             "metro relocate ready",
+			action:           ReadinessRelocate,
+			status:           metroStatus,
+			drCluster:        drCluster,
+			primaryDRCluster: primaryDRCluster(ramenapi.ClusterFenceStateUnfenced),
+		},
+		{
+			name:             "metro relocate primary fenced",
+			action:           ReadinessRelocate,
+			status:           metroStatus,
+			drCluster:        drCluster,
+			primaryDRCluster: primaryDRCluster(ramenapi.ClusterFenceStateManuallyFenced),
+			blocking:         []string{"Primary cluster \"dr1\" must be unfenced before relocate"},
+		},
+		{
+			name:      "metro primary drcluster not gathered",
+			action:    ReadinessFailover,
+			status:    metroStatus,
+			drCluster: drCluster,
+			nonBlocking: []string{
+				"DRCluster \"dr1\" was not gathered, primary cluster fencing was not checked",
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var d *ramenapi.DRCluster
+			if tc.drCluster != nil {
+				d = tc.drCluster()
+			}
+			var p *ramenapi.DRCluster
+			if tc.primaryDRCluster != nil {
+				p = tc.primaryDRCluster()
+			}
+			expected := &report.ApplicationReadiness{
+				Action:      tc.action,
+				Ready:       len(tc.blocking) == 0,
+				Blocking:    tc.blocking,
+				NonBlocking: tc.nonBlocking,
+			}
+			readiness := applicationReadiness(tc.action, tc.status(), d, p)
+			if !readiness.Equal(expected) {
+				t.Fatalf("unexpected result\n%s", helpers.UnifiedDiff(t, expected, readiness))
+			}
+		})
+	}
+}
+
+func TestValidatedS3Profile(t *testing.T) {
+	cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+	cases := []struct {
+		name        string
+		result      s3.Result
+		state       report.ValidationState
+		description string
+	}{
+		{
+			name:   "gathered",
+			result: s3.Result{ProfileName: "minio-on-dr1", Objects: 3},
+			state:  report.OK,
+		},
+		{
+			name:        "failed",
+			result:      s3.Result{ProfileName: "minio-on-dr1", Err: errors.New("no data")},
+			state:       report.Problem,
+			description: "no data",
+		},
+		{
+			name:        "no objects",
+			result:      s3.Result{ProfileName: "minio-on-dr1"},
+			state:       report.Warning,
+			description: "No objects found for the application",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			validated := cmd.validatedS3Profile(tc.result)
+			if validated.Gathered.State != tc.state {
+				t.Errorf("expected state %q, got %q", tc.state, validated.Gathered.State)
+			}
+			if validated.Gathered.Description != tc.description {
+				t.Errorf("expected description %q, got %q",
+					tc.description, validated.Gathered.Description)
+			}
+			if validated.Gathered.Value != (tc.state == report.OK) {
+				t.Errorf("unexpected gathered value %v", validated.Gathered.Value)
+			}
+		})
+	}
+}
+
+func TestValidateReadinessAction(t *testing.T) {
+	for _, action := range []string{"", ReadinessFailover, ReadinessRelocate} {
+		if err := ValidateReadinessAction(action); err != nil {
+			t.Errorf("unexpected error for action %q: %s", action, err)
+		}
+	}
+	if err := ValidateReadinessAction("Failover"); err == nil {
+		t.Error("expected error for unknown action")
+	}
+}
+
 // writeDRPolicy writes a DRPolicy to the gathered hub data directory.
-func writeDRPolicy(t *testing.T, dataDir, name, schedulingInterval string) {
+func writeDRPolicy(
+	t *testing.T,
+	dataDir, name, schedulingInterval string,
+	drClusters ...string,
+) {
 	t.Helper()
 
 	drPolicy := &ramenapi.DRPolicy{
@@ -926,15 +1864,43 @@ func writeDRPolicy(t *testing.T, dataDir, name, schedulingInterval string) {
 		},
 		Spec: ramenapi.DRPolicySpec{
 			SchedulingInterval: schedulingInterval,
+			DRClusters:         drClusters,
+		},
+	}
+
+	writeHubResource(t, dataDir, "drpolicies", name, drPolicy)
+}
+
+// writeDRCluster writes a DRCluster to the gathered hub data directory.
+func writeDRCluster(t *testing.T, dataDir, name, region string) {
+	t.Helper()
+
+	drCluster := &ramenapi.DRCluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: ramenapi.GroupVersion.String(),
+			Kind:       "DRCluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: ramenapi.DRClusterSpec{
+			Region: ramenapi.Region(region),
 		},
 	}
 
-	data, err := yaml.Marshal(drPolicy)
+	writeHubResource(t, dataDir, "drclusters", name, drCluster)
+}
+
+// writeHubResource writes a cluster scoped ramen resource to the gathered hub data directory.
+func writeHubResource(t *testing.T, dataDir, plural, name string, obj any) {
+	t.Helper()
+
+	data, err := yaml.Marshal(obj)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	dir := filepath.Join(dataDir, "hub", "cluster", "ramendr.openshift.io", "drpolicies")
+	dir := filepath.Join(dataDir, "hub", "cluster", "ramendr.openshift.io", plural)
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		t.Fatal(err)
 	}
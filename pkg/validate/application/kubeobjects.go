@@ -0,0 +1,302 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	stdtime "time"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	e2etypes "github.com/ramendr/ramen/e2e/types"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ramendr/ramenctl/pkg/ramen"
+	"github.com/ramendr/ramenctl/pkg/recipe"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// validatedKubeObjectProtection validates the application kube objects protection: the recipe
+// referenced by the DRPC, the last kube objects capture, and the backup tooling on the managed
+// clusters. Returns nil if the DRPC does not enable kube objects protection.
+func (c *Command) validatedKubeObjectProtection(
+	drpc *ramenapi.DRPlacementControl,
+) (*report.KubeObjectProtectionSummary, error) {
+	spec := drpc.Spec.KubeObjectProtection
+	if spec == nil {
+		return nil, nil
+	}
+
+	primaryCluster, err := ramen.PrimaryCluster(c, drpc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find primary cluster: %w", err)
+	}
+	secondaryCluster, err := ramen.SecondaryCluster(c, drpc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find secondary cluster: %w", err)
+	}
+
+	captureInterval := ramen.KubeObjectsCaptureIntervalDefault
+	if spec.CaptureInterval != nil {
+		captureInterval = spec.CaptureInterval.Duration
+	}
+
+	s := &report.KubeObjectProtectionSummary{CaptureInterval: captureInterval.String()}
+
+	s.LastCaptureTime, err = c.validatedLastCaptureTime(primaryCluster, drpc, captureInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.RecipeRef != nil && spec.RecipeRef.Name != "" {
+		s.Recipe, err = c.validatedRecipe(primaryCluster, drpc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, cluster := range []*e2etypes.Cluster{primaryCluster, secondaryCluster} {
+		tooling, err := c.validatedKubeObjectsTooling(cluster)
+		if err != nil {
+			return nil, err
+		}
+		if tooling != nil {
+			s.Tooling = append(s.Tooling, *tooling)
+		}
+	}
+
+	return s, nil
+}
+
+// validatedLastCaptureTime validates the start time of the kube objects capture to recover from,
+// reported by the VRG on the primary cluster. The capture time is not validated if the VRG does not
+// exist, since a missing VRG is reported by the VRG validation.
+func (c *Command) validatedLastCaptureTime(
+	cluster *e2etypes.Cluster,
+	drpc *ramenapi.DRPlacementControl,
+	captureInterval stdtime.Duration,
+) (report.ValidatedTime, error) {
+	log := c.Logger()
+	reader := c.OutputReader(cluster.Name)
+
+	vrg, err := ramen.ReadVRG(reader, drpc.Name, ramen.VRGNamespace(drpc))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return report.ValidatedTime{}, fmt.Errorf("failed to read vrg from cluster %q: %w",
+				cluster.Name, err)
+		}
+		return report.ValidatedTime{}, nil
+	}
+
+	clusterTime, err := ramen.ClusterTime(vrg.Annotations)
+	if err != nil {
+		log.Warnf("VRG \"%s/%s\" on cluster %q: %s", vrg.Namespace, vrg.Name, cluster.Name, err)
+	}
+
+	var captureTime *metav1.Time
+	if capture := vrg.Status.KubeObjectProtection.CaptureToRecoverFrom; capture != nil {
+		captureTime = &capture.StartTime
+	}
+
+	return c.validateLastCaptureTime(captureTime, clusterTime, captureInterval), nil
+}
+
+func (c *Command) validateLastCaptureTime(
+	captureTime *metav1.Time,
+	clusterTime *stdtime.Time,
+	captureInterval stdtime.Duration,
+) report.ValidatedTime {
+	if captureTime == nil || captureTime.IsZero() {
+		return c.validatedTime(nil, report.Warning,
+			"Waiting for first kube objects capture")
+	}
+
+	// metav1.Time.UnmarshalJSON converts timestamps to local time. We convert to UTC
+	// for consistency with other timestamps in YAML and HTML reports.
+	t := captureTime.UTC()
+
+	// Cannot validate without cluster time or valid capture interval - should not happen.
+	if clusterTime == nil || captureInterval <= 0 {
+		return report.ValidatedTime{Value: &t}
+	}
+
+	// Use the same thresholds as the volume synchronization.
+	intervals := float64(clusterTime.Sub(t)) / float64(captureInterval)
+
+	if intervals >= 3 {
+		return c.validatedTime(&t, report.Problem,
+			"Kube objects capture is exceeding 3x the capture interval")
+	}
+
+	if intervals > 2 {
+		return c.validatedTime(&t, report.Warning,
+			"Kube objects capture is exceeding 2x the capture interval")
+	}
+
+	return c.validatedTime(&t, report.OK, "")
+}
+
+// validatedRecipe validates that the recipe referenced by the DRPC exists on the primary cluster,
+// can be parsed, and that the recipe hooks select existing resources.
+func (c *Command) validatedRecipe(
+	cluster *e2etypes.Cluster,
+	drpc *ramenapi.DRPlacementControl,
+) (*report.RecipeSummary, error) {
+	log := c.Logger()
+	reader := c.OutputReader(cluster.Name)
+	spec := drpc.Spec.KubeObjectProtection
+
+	s := &report.RecipeSummary{
+		Name:      spec.RecipeRef.Name,
+		Namespace: spec.RecipeRef.Namespace,
+	}
+	// Ramen looks up the recipe in the VRG namespace if the namespace is not specified.
+	if s.Namespace == "" {
+		s.Namespace = ramen.VRGNamespace(drpc)
+	}
+
+	r, err := recipe.ReadRecipe(reader, s.Name, s.Namespace)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Debugf("recipe \"%s/%s\" missing in cluster %q", s.Namespace, s.Name, cluster.Name)
+			s.Deleted = c.ValidatedDeleted(nil)
+			return s, nil
+		}
+		if !errors.Is(err, recipe.ErrInvalidRecipe) {
+			return nil, fmt.Errorf("failed to read recipe \"%s/%s\" from cluster %q: %w",
+				s.Namespace, s.Name, cluster.Name, err)
+		}
+		s.Deleted = report.ValidatedBool{Validated: report.Validated{State: report.OK}}
+		summary.AddValidation(c.Report.Summary, &s.Deleted)
+		s.Valid = c.validatedRecipeValid(err)
+		return s, nil
+	}
+
+	log.Debugf("Read recipe \"%s/%s\" from cluster %q", s.Namespace, s.Name, cluster.Name)
+	s.Deleted = c.ValidatedDeleted(r)
+	s.Valid = c.validatedRecipeValid(nil)
+
+	for i := range r.Spec.Hooks {
+		hook := &r.Spec.Hooks[i]
+		namespace := recipe.ExpandParameters(hook.Namespace, spec.RecipeParameters)
+		if namespace == "" {
+			namespace = s.Namespace
+		}
+		hs := report.RecipeHookSummary{Name: hook.Name, Namespace: namespace}
+		hs.Resources = c.validatedHookResources(cluster, hook, namespace, drpc)
+		s.Hooks = append(s.Hooks, hs)
+	}
+
+	return s, nil
+}
+
+func (c *Command) validatedRecipeValid(err error) *report.ValidatedBool {
+	validated := &report.ValidatedBool{Value: err == nil}
+	if err != nil {
+		validated.State = report.Problem
+		validated.Description = err.Error()
+	} else {
+		validated.State = report.OK
+	}
+	summary.AddValidation(c.Report.Summary, validated)
+	return validated
+}
+
+// validatedHookResources validates that the hook selects existing resources. Hooks in namespaces
+// that were not gathered are not validated.
+func (c *Command) validatedHookResources(
+	cluster *e2etypes.Cluster,
+	hook *recipe.Hook,
+	namespace string,
+	drpc *ramenapi.DRPlacementControl,
+) report.ValidatedString {
+	if !slices.Contains(ramen.ApplicationNamespaces(drpc), namespace) {
+		c.Logger().Debugf("Namespace %q of hook %q was not gathered", namespace, hook.Name)
+		return report.ValidatedString{}
+	}
+
+	reader := c.OutputReader(cluster.Name)
+	validated := report.ValidatedString{}
+
+	names, err := recipe.HookResources(reader, hook, namespace)
+	switch {
+	case err != nil:
+		validated.State = report.Problem
+		validated.Description = err.Error()
+	case len(names) == 0:
+		resource := hook.SelectResource
+		if resource == "" {
+			resource = recipe.SelectResourcePod
+		}
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("No %s matching hook selector in namespace %q",
+			resource, namespace)
+	default:
+		validated.Value = strings.Join(names, ", ")
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// validatedKubeObjectsTooling validates that the velero deployment expected by the ramen config
+// exists on the cluster. Returns nil if the ramen config or the velero namespace were not gathered.
+func (c *Command) validatedKubeObjectsTooling(
+	cluster *e2etypes.Cluster,
+) (*report.KubeObjectsToolingSummary, error) {
+	log := c.Logger()
+	reader := c.OutputReader(cluster.Name)
+
+	operator := ramen.Operator(c.Config(), cluster.Name, ramenapi.DRClusterType)
+	ramenConfig, err := ramen.ReadOperatorConfig(reader, operator)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read ramen config from cluster %q: %w",
+				cluster.Name, err)
+		}
+		log.Debugf("Ramen config was not gathered from cluster %q", cluster.Name)
+		return nil, nil
+	}
+
+	namespace := ramen.VeleroNamespace(ramenConfig)
+	s := &report.KubeObjectsToolingSummary{
+		Cluster: cluster.Name,
+		Velero:  report.ValidatedString{Value: namespace},
+	}
+
+	if ramenConfig.KubeObjectProtection.Disabled {
+		s.Velero.State = report.Problem
+		s.Velero.Description = "Kube objects protection is disabled in ramen config"
+		summary.AddValidation(c.Report.Summary, &s.Velero)
+		return s, nil
+	}
+
+	if !slices.Contains(ramen.VeleroNamespaces, namespace) {
+		log.Debugf("Velero namespace %q was not gathered from cluster %q", namespace, cluster.Name)
+		return nil, nil
+	}
+
+	names, err := reader.ListResources(namespace, appsv1.GroupName+"/deployments")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments in namespace %q from cluster %q: %w",
+			namespace, cluster.Name, err)
+	}
+
+	if slices.Contains(names, ramen.VeleroDeploymentName) {
+		s.Velero.State = report.OK
+	} else {
+		s.Velero.State = report.Problem
+		s.Velero.Description = fmt.Sprintf("Velero deployment not found in namespace %q",
+			namespace)
+	}
+
+	summary.AddValidation(c.Report.Summary, &s.Velero)
+	return s, nil
+}
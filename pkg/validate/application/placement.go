@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	e2etypes "github.com/ramendr/ramen/e2e/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ocmworkv1 "open-cluster-management.io/api/work/v1"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+	"github.com/ramendr/ramenctl/pkg/ocm"
+	"github.com/ramendr/ramenctl/pkg/ramen"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// validatedPlacement validates that the application placement decision agrees with the DRPC
+// primary cluster. Returns nil if the application does not use an OCM Placement.
+func (c *Command) validatedPlacement(
+	drpc *ramenapi.DRPlacementControl,
+	primaryCluster string,
+) (*report.PlacementSummary, error) {
+	log := c.Logger()
+	ref := drpc.Spec.PlacementRef
+
+	if ref.Kind != ocm.PlacementKind {
+		log.Debugf("Skipping placement %q of kind %q", ref.Name, ref.Kind)
+		return nil, nil
+	}
+
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = drpc.Namespace
+	}
+
+	s := &report.PlacementSummary{Name: ref.Name, Namespace: namespace}
+	reader := c.OutputReader(c.Env().Hub.Name)
+
+	placement, err := ocm.ReadPlacement(reader, ref.Name, namespace)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read placement \"%s/%s\": %w",
+				namespace, ref.Name, err)
+		}
+		log.Debugf("placement \"%s/%s\" missing in cluster %q",
+			namespace, ref.Name, c.Env().Hub.Name)
+		s.Deleted = c.ValidatedDeleted(nil)
+		return s, nil
+	}
+
+	log.Debugf("Read placement \"%s/%s\"", namespace, ref.Name)
+	s.Deleted = c.ValidatedDeleted(placement)
+
+	clusters, err := ocm.PlacementDecisionClusters(reader, placement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read placement \"%s/%s\" decisions: %w",
+			namespace, ref.Name, err)
+	}
+
+	s.Decision = c.validatedPlacementDecision(clusters, primaryCluster)
+
+	return s, nil
+}
+
+func (c *Command) validatedPlacementDecision(
+	clusters []string,
+	primaryCluster string,
+) report.ValidatedString {
+	validated := report.ValidatedString{Value: strings.Join(clusters, ", ")}
+
+	switch {
+	case len(clusters) == 0:
+		validated.State = report.Problem
+		validated.Description = "Placement has no decision"
+	case len(clusters) > 1 || clusters[0] != primaryCluster:
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf(
+			"Placement decision does not match primary cluster %q", primaryCluster)
+	default:
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// validatedManifestWorks validates the ManifestWorks delivering the VRG and the application
+// namespace to the cluster. The VRG ManifestWork is required on the primary and secondary
+// clusters. Ramen creates the namespace ManifestWork only for some applications, so it is
+// validated only if it exists.
+func (c *Command) validatedManifestWorks(
+	cluster *e2etypes.Cluster,
+	drpc *ramenapi.DRPlacementControl,
+) ([]report.ManifestWorkSummary, error) {
+	reader := c.OutputReader(c.Env().Hub.Name)
+	var works []report.ManifestWorkSummary
+
+	vrgWork, err := c.validatedManifestWork(reader, ramen.VRGManifestWorkName(drpc), cluster, true)
+	if err != nil {
+		return nil, err
+	}
+	works = append(works, *vrgWork)
+
+	nsWork, err := c.validatedManifestWork(
+		reader,
+		ramen.NamespaceManifestWorkName(drpc),
+		cluster,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if nsWork != nil {
+		works = append(works, *nsWork)
+	}
+
+	return works, nil
+}
+
+func (c *Command) validatedManifestWork(
+	reader gathering.OutputReader,
+	name string,
+	cluster *e2etypes.Cluster,
+	required bool,
+) (*report.ManifestWorkSummary, error) {
+	log := c.Logger()
+	s := &report.ManifestWorkSummary{Name: name}
+
+	work, err := ocm.ReadManifestWork(reader, name, cluster.Name)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read manifestwork \"%s/%s\": %w",
+				cluster.Name, name, err)
+		}
+		log.Debugf("manifestwork \"%s/%s\" missing in cluster %q",
+			cluster.Name, name, c.Env().Hub.Name)
+		if !required {
+			return nil, nil
+		}
+		s.Deleted = c.ValidatedDeleted(nil)
+		return s, nil
+	}
+
+	log.Debugf("Read manifestwork \"%s/%s\"", cluster.Name, name)
+	s.Deleted = c.ValidatedDeleted(work)
+
+	for _, conditionType := range []string{ocmworkv1.WorkApplied, ocmworkv1.WorkAvailable} {
+		validated := c.ValidatedRequiredCondition(
+			work.Status.Conditions,
+			conditionType,
+			metav1.ConditionTrue,
+		)
+		s.Conditions = append(s.Conditions, validated)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	e2etypes "github.com/ramendr/ramen/e2e/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/ramendr/ramenctl/pkg/core"
+	"github.com/ramendr/ramenctl/pkg/ramen"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// validatedPVCs validates that the PVCs in the application namespaces on the cluster are selected
+// by the DRPC pvcSelector and protected by the VRG. Returns nil if no PVCs were gathered.
+func (c *Command) validatedPVCs(
+	cluster *e2etypes.Cluster,
+	drpc *ramenapi.DRPlacementControl,
+) ([]report.PVCSummary, error) {
+	log := c.Logger()
+	reader := c.OutputReader(cluster.Name)
+
+	selector, err := metav1.LabelSelectorAsSelector(&drpc.Spec.PVCSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid drpc pvc selector: %w", err)
+	}
+
+	protected, err := c.protectedPVCs(cluster, drpc)
+	if err != nil {
+		return nil, err
+	}
+
+	peerClasses := c.peerClasses(drpc)
+
+	var pvcs []report.PVCSummary
+	for _, namespace := range ramen.ProtectedNamespaces(drpc) {
+		names, err := core.ListPVCs(reader, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pvcs in namespace %q from cluster %q: %w",
+				namespace, cluster.Name, err)
+		}
+
+		for _, name := range names {
+			pvc, err := core.ReadPVC(reader, name, namespace)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read pvc \"%s/%s\" from cluster %q: %w",
+					namespace, name, cluster.Name, err)
+			}
+			log.Debugf("Read pvc \"%s/%s\" from cluster %q", namespace, name, cluster.Name)
+
+			ps := report.PVCSummary{Name: pvc.Name, Namespace: pvc.Namespace}
+			if pvc.Spec.StorageClassName != nil {
+				ps.StorageClass = *pvc.Spec.StorageClassName
+			}
+
+			key := types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}
+			if ppvc, ok := protected[key]; ok {
+				ps.Replication = c.protectedPVCReplication(ppvc)
+			} else {
+				ps.Replication = pvcReplication(ps.StorageClass, peerClasses)
+			}
+
+			selected := selector.Matches(labels.Set(pvc.Labels))
+			ps.Protected = c.validatedPVCProtected(selected, protected[key] != nil)
+
+			pvcs = append(pvcs, ps)
+		}
+	}
+
+	return pvcs, nil
+}
+
+// protectedPVCs returns the PVCs protected by the VRG on the cluster. Returns an empty map if the
+// VRG does not exist, since a missing VRG is reported by the VRG validation.
+func (c *Command) protectedPVCs(
+	cluster *e2etypes.Cluster,
+	drpc *ramenapi.DRPlacementControl,
+) (map[types.NamespacedName]*ramenapi.ProtectedPVC, error) {
+	reader := c.OutputReader(cluster.Name)
+	protected := map[types.NamespacedName]*ramenapi.ProtectedPVC{}
+
+	vrg, err := ramen.ReadVRG(reader, drpc.Name, ramen.VRGNamespace(drpc))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read vrg from cluster %q: %w", cluster.Name, err)
+		}
+		return protected, nil
+	}
+
+	for i := range vrg.Status.ProtectedPVCs {
+		ppvc := &vrg.Status.ProtectedPVCs[i]
+		protected[types.NamespacedName{Namespace: ppvc.Namespace, Name: ppvc.Name}] = ppvc
+	}
+
+	return protected, nil
+}
+
+// peerClasses returns the peer classes of the application DRPolicy. Returns nil if the DRPolicy
+// cannot be read; the DRPolicy is validated with the DRPC scheduling interval.
+func (c *Command) peerClasses(drpc *ramenapi.DRPlacementControl) []ramenapi.PeerClass {
+	reader := c.OutputReader(c.Env().Hub.Name)
+
+	drPolicy, err := ramen.ReadDRPolicy(reader, drpc.Spec.DRPolicyRef.Name)
+	if err != nil {
+		c.Logger().Warnf("Failed to read drpolicy %q: %s", drpc.Spec.DRPolicyRef.Name, err)
+		return nil
+	}
+
+	var peerClasses []ramenapi.PeerClass
+	peerClasses = append(peerClasses, drPolicy.Status.Async.PeerClasses...)
+	peerClasses = append(peerClasses, drPolicy.Status.Sync.PeerClasses...)
+	return peerClasses
+}
+
+func (c *Command) validatedPVCProtected(selected, protected bool) report.ValidatedBool {
+	validated := report.ValidatedBool{Value: protected}
+
+	switch {
+	case protected:
+		validated.State = report.OK
+	case selected:
+		validated.State = report.Problem
+		validated.Description = "PVC is selected but not protected"
+	default:
+		// The PVC may be excluded intentionally, but this is a common mistake.
+		validated.State = report.Warning
+		validated.Description = "PVC does not match the DRPC pvcSelector"
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// pvcReplication returns the replication type ramen will use for a PVC with the storage class, or
+// an empty value if the storage class is not in the DRPolicy peer classes. Peer classes with a
+// replication ID use volrep, other peer classes use volsync.
+func pvcReplication(storageClass string, peerClasses []ramenapi.PeerClass) report.ReplicationType {
+	for i := range peerClasses {
+		if peerClasses[i].StorageClassName != storageClass {
+			continue
+		}
+		if peerClasses[i].ReplicationID != "" {
+			return report.Volrep
+		}
+		return report.Volsync
+	}
+	return ""
+}
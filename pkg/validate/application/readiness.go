@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	"github.com/ramendr/ramenctl/pkg/ramen"
+	"github.com/ramendr/ramenctl/pkg/report"
+	validatecmd "github.com/ramendr/ramenctl/pkg/validate/command"
+)
+
+const (
+	// ReadinessFailover and ReadinessRelocate are the DR actions we can check readiness for.
+	ReadinessFailover = "failover"
+	ReadinessRelocate = "relocate"
+)
+
+// ValidateReadinessAction returns an error if action is not empty and is not a known readiness
+// action.
+func ValidateReadinessAction(action string) error {
+	if action == "" || action == ReadinessFailover || action == ReadinessRelocate {
+		return nil
+	}
+	return fmt.Errorf("invalid readiness action %q (choose from %q, %q)",
+		action, ReadinessFailover, ReadinessRelocate)
+}
+
+// validatedReadiness evaluates the preconditions for the readiness action using the validated
+// application status. The target cluster of both failover and relocate is the current secondary
+// cluster.
+func (c *Command) validatedReadiness(
+	s *report.ApplicationStatus,
+) (*report.ApplicationReadiness, error) {
+	drCluster, err := c.readReadinessDRCluster(s.SecondaryCluster.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Metro DR requires fencing the primary cluster before failover.
+	var primaryDRCluster *ramenapi.DRCluster
+	if s.Hub.DRPC.Mode == report.MetroDR {
+		primaryDRCluster, err = c.readReadinessDRCluster(s.PrimaryCluster.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return applicationReadiness(c.opts.ReadinessAction, s, drCluster, primaryDRCluster), nil
+}
+
+// readReadinessDRCluster reads the DRCluster from the hub, or returns nil if the DRCluster was not
+// gathered.
+func (c *Command) readReadinessDRCluster(name string) (*ramenapi.DRCluster, error) {
+	reader := c.OutputReader(c.Env().Hub.Name)
+
+	drCluster, err := ramen.ReadDRCluster(reader, name)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read drcluster %q: %w", name, err)
+		}
+		c.Logger().Debugf("DRCluster %q was not gathered", name)
+		return nil, nil
+	}
+
+	return drCluster, nil
+}
+
+// applicationReadiness returns the readiness verdict for the action. Problems in the validated
+// application status block the action, warnings are reported as non-blocking reasons. Some
+// problems on the primary cluster are expected when failing over from a failed cluster, so they do
+// not block a failover. The primary DRCluster is used only for Metro DR.
+func applicationReadiness(
+	action string,
+	s *report.ApplicationStatus,
+	drCluster *ramenapi.DRCluster,
+	primaryDRCluster *ramenapi.DRCluster,
+) *report.ApplicationReadiness {
+	r := &readinessBuilder{readiness: &report.ApplicationReadiness{Action: action}}
+	failover := action == ReadinessFailover
+	drpc := &s.Hub.DRPC
+
+	r.addValidated(drpc.Deleted.Validated, "DRPC", true)
+	r.addValidated(drpc.Phase.Validated, fmt.Sprintf("DRPC phase %q", drpc.Phase.Value), true)
+	r.addValidated(drpc.Progression.Validated,
+		fmt.Sprintf("DRPC progression %q", drpc.Progression.Value), true)
+	if drpc.Progress != nil {
+		r.addValidated(drpc.Progress.ProgressionElapsed.Validated, "DRPC progress", true)
+	}
+
+	// Failover does not require the peer to be ready, since the peer may be lost.
+	peerReady := slices.ContainsFunc(drpc.Conditions, func(c report.ValidatedCondition) bool {
+		return c.Type == ramenapi.ConditionPeerReady && c.State == report.OK
+	})
+	if !peerReady {
+		r.add(!failover, "DRPC is not peer ready")
+	}
+
+	// Metro DR replicates synchronously, so there is no last group sync time to check, but the
+	// primary cluster fencing must match the action.
+	if drpc.Mode == report.MetroDR {
+		r.addMetroFencing(action, s.PrimaryCluster.Name, primaryDRCluster)
+	} else if failover && drpc.LastGroupSyncTime.Value == nil {
+		r.add(true, "No volume synchronization completed, no data to fail over")
+	} else {
+		r.addValidated(drpc.LastGroupSyncTime.Validated, "DRPC last group sync time", true)
+	}
+
+	if drCluster == nil {
+		r.add(false, fmt.Sprintf("DRCluster %q was not gathered, fencing and maintenance "+
+			"mode were not checked", s.SecondaryCluster.Name))
+	} else {
+		if validatecmd.IsDeleted(drCluster) {
+			r.add(true, fmt.Sprintf("Target cluster %q DRCluster was deleted", drCluster.Name))
+		}
+		if isFenced(drCluster) {
+			r.add(true, fmt.Sprintf("Target cluster %q is fenced", drCluster.Name))
+		}
+		if len(drCluster.Status.MaintenanceModes) > 0 {
+			r.add(true, fmt.Sprintf("Target cluster %q is in maintenance mode", drCluster.Name))
+		}
+	}
+
+	r.addVRG(&s.SecondaryCluster, "Secondary", true)
+	r.addVRG(&s.PrimaryCluster, "Primary", !failover)
+
+	if s.VRGRoles != nil {
+		r.addValidated(s.VRGRoles.Validated, "VRG roles", true)
+	}
+
+	r.addValidated(s.S3.Profiles.Validated, "S3 profiles", true)
+	for i := range s.S3.Profiles.Value {
+		r.addS3Profile(&s.S3.Profiles.Value[i])
+	}
+
+	r.readiness.Ready = len(r.readiness.Blocking) == 0
+	return r.readiness
+}
+
+func isFenced(drCluster *ramenapi.DRCluster) bool {
+	switch drCluster.Spec.ClusterFence {
+	case ramenapi.ClusterFenceStateFenced, ramenapi.ClusterFenceStateManuallyFenced:
+		return true
+	}
+	return meta.IsStatusConditionTrue(
+		drCluster.Status.Conditions,
+		ramenapi.DRClusterConditionTypeFenced,
+	)
+}
+
+type readinessBuilder struct {
+	readiness *report.ApplicationReadiness
+}
+
+func (b *readinessBuilder) add(blocking bool, reason string) {
+	if blocking {
+		b.readiness.Blocking = append(b.readiness.Blocking, reason)
+	} else {
+		b.readiness.NonBlocking = append(b.readiness.NonBlocking, reason)
+	}
+}
+
+// addValidated adds a reason for a problem or warning. Problems are blocking only if blocking is
+// true, warnings are never blocking.
+func (b *readinessBuilder) addValidated(v report.Validated, name string, blocking bool) {
+	switch v.State {
+	case report.Problem:
+		b.add(blocking, fmt.Sprintf("%s: %s", name, v.Description))
+	case report.Warning:
+		b.add(false, fmt.Sprintf("%s: %s", name, v.Description))
+	}
+}
+
+// addMetroFencing adds reasons for the primary cluster fencing state. With Metro DR both clusters
+// use the same storage, so the primary cluster must be fenced before failover to prevent writes
+// from both clusters, and must be unfenced before relocate.
+func (b *readinessBuilder) addMetroFencing(
+	action, primaryName string,
+	primaryDRCluster *ramenapi.DRCluster,
+) {
+	if primaryDRCluster == nil {
+		b.add(false, fmt.Sprintf("DRCluster %q was not gathered, primary cluster fencing was "+
+			"not checked", primaryName))
+		return
+	}
+
+	fenced := isFenced(primaryDRCluster)
+	if action == ReadinessFailover && !fenced {
+		b.add(true, fmt.Sprintf("Primary cluster %q must be fenced before failover",
+			primaryDRCluster.Name))
+	}
+	if action == ReadinessRelocate && fenced {
+		b.add(true, fmt.Sprintf("Primary cluster %q must be unfenced before relocate",
+			primaryDRCluster.Name))
+	}
+}
+
+// addS3Profile adds a reason for a profile that was not gathered. A profile without the
+// application objects is only a warning in the validated status, but the application cannot be
+// recovered without them, so it blocks the action.
+func (b *readinessBuilder) addS3Profile(profile *report.ApplicationS3ProfileStatus) {
+	name := fmt.Sprintf("S3 profile %q", profile.Name)
+	gathered := &profile.Gathered
+	if gathered.State == report.Warning && !gathered.Value {
+		b.add(true, fmt.Sprintf("%s: %s", name, gathered.Description))
+		return
+	}
+	b.addValidated(gathered.Validated, name, true)
+}
+
+func (b *readinessBuilder) addVRG(s *report.ApplicationStatusCluster, role string, blocking bool) {
+	vrg := &s.VRG
+	name := fmt.Sprintf("%s VRG on cluster %q", role, s.Name)
+
+	b.addValidated(vrg.Deleted.Validated, name, blocking)
+	b.addValidated(vrg.State.Validated, name+" state", blocking)
+	for i := range vrg.Conditions {
+		condition := &vrg.Conditions[i]
+		b.addValidated(condition.Validated,
+			fmt.Sprintf("%s condition %q", name, condition.Type), blocking)
+	}
+}
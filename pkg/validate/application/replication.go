@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	e2etypes "github.com/ramendr/ramen/e2e/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ramendr/ramenctl/pkg/ramen"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/storage"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+	"github.com/ramendr/ramenctl/pkg/volsync"
+)
+
+// validateReplicationResources validates the resources replicating the protected PVCs on the
+// primary and secondary clusters. Resources are validated only if resources of the same kind were
+// gathered from the PVC namespace.
+func (c *Command) validateReplicationResources(
+	s *report.VRGSummary,
+	primaryCluster, secondaryCluster *e2etypes.Cluster,
+) error {
+	for i := range s.ProtectedPVCs {
+		ps := &s.ProtectedPVCs[i]
+		ps.ReplicationResources = nil
+
+		for _, cluster := range []*e2etypes.Cluster{primaryCluster, secondaryCluster} {
+			primary := cluster == primaryCluster
+
+			var rs *report.ReplicationResourceSummary
+			var err error
+			switch ps.Replication {
+			case report.Volrep:
+				rs, err = c.validatedVolumeReplication(cluster, ps, primary)
+			case report.Volsync:
+				rs, err = c.validatedVolSyncReplication(cluster, ps, primary, s.SchedulingInterval)
+			}
+			if err != nil {
+				return err
+			}
+			if rs != nil {
+				ps.ReplicationResources = append(ps.ReplicationResources, *rs)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validatedVolumeReplication validates the VolumeReplication for the PVC, or the
+// VolumeGroupReplication including the PVC if the PVC is protected by a consistency group.
+func (c *Command) validatedVolumeReplication(
+	cluster *e2etypes.Cluster,
+	ps *report.ProtectedPVCSummary,
+	primary bool,
+) (*report.ReplicationResourceSummary, error) {
+	log := c.Logger()
+	reader := c.OutputReader(cluster.Name)
+
+	names, err := storage.ListVolumeReplications(reader, ps.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volume replications in namespace %q "+
+			"from cluster %q: %w", ps.Namespace, cluster.Name, err)
+	}
+
+	vgrs, err := storage.ReadVolumeGroupReplications(reader, ps.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read volume group replications in namespace %q "+
+			"from cluster %q: %w", ps.Namespace, cluster.Name, err)
+	}
+
+	if len(names) == 0 && len(vgrs) == 0 {
+		log.Debugf("No volume replications gathered in namespace %q from cluster %q",
+			ps.Namespace, cluster.Name)
+		return nil, nil
+	}
+
+	rs := &report.ReplicationResourceSummary{
+		Kind:    storage.VolumeReplicationKind,
+		Name:    ps.Name,
+		Cluster: cluster.Name,
+	}
+
+	var vr *storage.VolumeReplication
+	if slices.Contains(names, ps.Name) {
+		vr, err = storage.ReadVolumeReplication(reader, ps.Name, ps.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read volume replication \"%s/%s\" "+
+				"from cluster %q: %w", ps.Namespace, ps.Name, cluster.Name, err)
+		}
+	} else if vgr := volumeGroupReplicationForPVC(vgrs, ps.Name); vgr != nil {
+		vr = vgr
+		rs.Kind = storage.VolumeGroupReplicationKind
+		rs.Name = vgr.Name
+	}
+
+	if vr == nil {
+		log.Debugf("volume replication for pvc \"%s/%s\" missing in cluster %q",
+			ps.Namespace, ps.Name, cluster.Name)
+		rs.Deleted = c.ValidatedDeleted(nil)
+		return rs, nil
+	}
+
+	log.Debugf("Read %s \"%s/%s\" from cluster %q", rs.Kind, ps.Namespace, rs.Name, cluster.Name)
+	rs.Deleted = c.ValidatedDeleted(vr)
+
+	expectedState := storage.ReplicationStateSecondary
+	if primary {
+		expectedState = storage.ReplicationStatePrimary
+	}
+	state := c.validatedVolumeReplicationState(vr.Status.State, expectedState)
+	rs.State = &state
+	rs.Conditions = c.validatedVolumeReplicationConditions(vr.Status.Conditions)
+
+	return rs, nil
+}
+
+func volumeGroupReplicationForPVC(
+	vgrs []*storage.VolumeReplication,
+	pvcName string,
+) *storage.VolumeReplication {
+	for _, vgr := range vgrs {
+		if slices.Contains(vgr.Status.PVCRefs, corev1.LocalObjectReference{Name: pvcName}) {
+			return vgr
+		}
+	}
+	return nil
+}
+
+func (c *Command) validatedVolumeReplicationState(
+	state, expectedState string,
+) report.ValidatedString {
+	validated := report.ValidatedString{Value: state}
+
+	if state != expectedState {
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("Waiting to become %q", expectedState)
+	} else {
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+func (c *Command) validatedVolumeReplicationConditions(
+	conditions []metav1.Condition,
+) []report.ValidatedCondition {
+	validated := []report.ValidatedCondition{
+		c.ValidatedRequiredCondition(
+			conditions,
+			storage.ReplicationConditionCompleted,
+			metav1.ConditionTrue,
+		),
+	}
+
+	// The Degraded and Resyncing conditions are not reported by all csi-addons versions.
+	for _, conditionType := range []string{
+		storage.ReplicationConditionDegraded,
+		storage.ReplicationConditionResyncing,
+	} {
+		if meta.FindStatusCondition(conditions, conditionType) != nil {
+			validated = append(validated, c.ValidatedRequiredCondition(
+				conditions,
+				conditionType,
+				metav1.ConditionFalse,
+			))
+		}
+	}
+
+	return validated
+}
+
+// validatedVolSyncReplication validates the ReplicationSource on the primary cluster or the
+// ReplicationDestination on the secondary cluster, and the VolumeSnapshotClass used by volsync.
+func (c *Command) validatedVolSyncReplication(
+	cluster *e2etypes.Cluster,
+	ps *report.ProtectedPVCSummary,
+	primary bool,
+	schedulingInterval report.ValidatedDuration,
+) (*report.ReplicationResourceSummary, error) {
+	log := c.Logger()
+	reader := c.OutputReader(cluster.Name)
+
+	list := volsync.ListReplicationDestinations
+	read := volsync.ReadReplicationDestination
+	kind := volsync.ReplicationDestinationKind
+	if primary {
+		list = volsync.ListReplicationSources
+		read = volsync.ReadReplicationSource
+		kind = volsync.ReplicationSourceKind
+	}
+
+	names, err := list(reader, ps.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s in namespace %q from cluster %q: %w",
+			kind, ps.Namespace, cluster.Name, err)
+	}
+	if len(names) == 0 {
+		log.Debugf("No %s gathered in namespace %q from cluster %q",
+			kind, ps.Namespace, cluster.Name)
+		return nil, nil
+	}
+
+	rs := &report.ReplicationResourceSummary{
+		Kind:    kind,
+		Name:    ps.Name,
+		Cluster: cluster.Name,
+	}
+
+	replication, err := read(reader, ps.Name, ps.Namespace)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read %s \"%s/%s\" from cluster %q: %w",
+				kind, ps.Namespace, ps.Name, cluster.Name, err)
+		}
+		log.Debugf("%s \"%s/%s\" missing in cluster %q", kind, ps.Namespace, ps.Name, cluster.Name)
+		rs.Deleted = c.ValidatedDeleted(nil)
+		return rs, nil
+	}
+
+	log.Debugf("Read %s \"%s/%s\" from cluster %q", kind, ps.Namespace, ps.Name, cluster.Name)
+	rs.Deleted = c.ValidatedDeleted(replication)
+
+	clusterTime, err := ramen.ClusterTime(replication.Annotations)
+	if err != nil {
+		log.Warnf("%s \"%s/%s\" on cluster %q: %s", kind, ps.Namespace, ps.Name, cluster.Name, err)
+	}
+
+	// A destination without a synchronization is waiting for the first synchronization, like the
+	// source, so both are validated as primary.
+	lastSyncTime := c.validateLastGroupSyncTime(
+		replication.Status.LastSyncTime, clusterTime, schedulingInterval, true)
+	rs.LastSyncTime = &lastSyncTime
+
+	if replication.Status.LastSyncDuration != nil {
+		rs.LastSyncDuration = replication.Status.LastSyncDuration.Duration.String()
+	}
+
+	rs.VolumeSnapshotClass, err = c.validatedVolumeSnapshotClass(cluster, replication)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+// validatedVolumeSnapshotClass validates that the VolumeSnapshotClass used by volsync exists.
+// Returns nil if the replication does not specify a class or volume snapshot classes were not
+// gathered.
+func (c *Command) validatedVolumeSnapshotClass(
+	cluster *e2etypes.Cluster,
+	replication *volsync.Replication,
+) (*report.ValidatedString, error) {
+	rsyncTLS := replication.Spec.RsyncTLS
+	if rsyncTLS == nil || rsyncTLS.VolumeSnapshotClassName == nil {
+		return nil, nil
+	}
+
+	name := *rsyncTLS.VolumeSnapshotClassName
+	reader := c.OutputReader(cluster.Name)
+
+	names, err := storage.ListVolumeSnapshotClasses(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volume snapshot classes from cluster %q: %w",
+			cluster.Name, err)
+	}
+	if len(names) == 0 {
+		c.Logger().Debugf("No volume snapshot classes gathered from cluster %q", cluster.Name)
+		return nil, nil
+	}
+
+	validated := &report.ValidatedString{Value: name}
+	if slices.Contains(names, name) {
+		validated.State = report.OK
+	} else {
+		validated.State = report.Problem
+		validated.Description = "VolumeSnapshotClass does not exist"
+	}
+
+	summary.AddValidation(c.Report.Summary, validated)
+	return validated, nil
+}
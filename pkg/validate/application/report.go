@@ -13,6 +13,8 @@ type Report struct {
 	*report.Report
 	Application       report.Application       `json:"application"`
 	ApplicationStatus report.ApplicationStatus `json:"applicationStatus"`
+	// Readiness is reported only when checking readiness for a DR action.
+	Readiness *report.ApplicationReadiness `json:"readiness,omitempty"`
 }
 
 // NewReport creates a new application validation report.
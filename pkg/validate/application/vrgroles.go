@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+
+	"github.com/ramendr/ramenctl/pkg/ramen"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// vrgRoles returns the roles of the application VRGs on all managed clusters, including clusters
+// not referenced by the application DRPolicy. Clusters without the application VRG are not
+// included.
+func (c *Command) vrgRoles(drpc *ramenapi.DRPlacementControl) ([]report.VRGRoleSummary, error) {
+	log := c.Logger()
+	vrgName := drpc.Name
+	vrgNamespace := ramen.VRGNamespace(drpc)
+
+	var roles []report.VRGRoleSummary
+	for _, cluster := range c.ManagedClusters() {
+		reader := c.OutputReader(cluster.Name)
+		vrg, err := ramen.ReadVRG(reader, vrgName, vrgNamespace)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				return nil, fmt.Errorf("failed to read vrg from cluster %q: %w", cluster.Name, err)
+			}
+			log.Debugf("vrg \"%s/%s\" missing in cluster %q", vrgNamespace, vrgName, cluster.Name)
+			continue
+		}
+		roles = append(roles, report.VRGRoleSummary{
+			Cluster:          cluster.Name,
+			ReplicationState: string(vrg.Spec.ReplicationState),
+			State:            string(vrg.Status.State),
+		})
+	}
+
+	return roles, nil
+}
+
+// validatedVRGRoles validates that the VRG roles are consistent with the DRPC action and phase.
+// Only one cluster may have a primary VRG when the DRPC is in a stable phase, and only the primary
+// and secondary clusters may have a VRG. During failover the failed cluster may still have a
+// primary VRG until it is recovered, so multiple primary VRGs are reported as a warning.
+func (c *Command) validatedVRGRoles(
+	drpc *ramenapi.DRPlacementControl,
+	roles []report.VRGRoleSummary,
+	primaryCluster, secondaryCluster string,
+) *report.ValidatedVRGRolesList {
+	validated := &report.ValidatedVRGRolesList{Value: roles}
+
+	var primaries, unexpected []string
+	for _, role := range roles {
+		if isPrimaryRole(role) {
+			primaries = append(primaries, role.Cluster)
+		}
+		if role.Cluster != primaryCluster && role.Cluster != secondaryCluster {
+			unexpected = append(unexpected, role.Cluster)
+		}
+	}
+
+	stablePhase, err := ramen.StablePhase(drpc.Spec.Action)
+	stable := err == nil && drpc.Status.Phase == stablePhase
+
+	switch {
+	case len(primaries) > 1 && stable:
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("Multiple primary vrgs on clusters %s",
+			strings.Join(primaries, ", "))
+	case len(unexpected) > 0:
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("Unexpected vrg on clusters %s",
+			strings.Join(unexpected, ", "))
+	case len(primaries) > 1:
+		validated.State = report.Warning
+		validated.Description = fmt.Sprintf("Multiple primary vrgs on clusters %s in phase %q",
+			strings.Join(primaries, ", "), drpc.Status.Phase)
+	default:
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, validated)
+	return validated
+}
+
+// isPrimaryRole returns true if the VRG is primary or is still primary while being demoted.
+func isPrimaryRole(role report.VRGRoleSummary) bool {
+	return role.ReplicationState == string(ramenapi.Primary) ||
+		role.State == string(ramenapi.PrimaryState)
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	stdtime "time"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/e2e/types"
+
+	"github.com/ramendr/ramenctl/pkg/ramen"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// validateClocks validates the clocks of the hub and managed clusters. The cluster time is the API
+// server time added by the gathering tool to the gathered ramen operator deployment. Since we know
+// only that the deployment was gathered while gathering the clusters, we report the minimal clock
+// skew compared to the local host and to the hub. Clusters without a cluster time are not
+// validated.
+func (c *Command) validateClocks() ([]report.ClusterClockSummary, error) {
+	type clusterType struct {
+		cluster        *types.Cluster
+		controllerType ramenapi.ControllerType
+	}
+
+	env := c.Env()
+	clusters := []clusterType{{env.Hub, ramenapi.DRHubType}}
+	for _, cluster := range c.ManagedClusters() {
+		clusters = append(clusters, clusterType{cluster, ramenapi.DRClusterType})
+	}
+
+	var clocks []report.ClusterClockSummary
+	var hubTime *stdtime.Time
+	for _, ct := range clusters {
+		clusterTime, err := c.clusterTime(ct.cluster, ct.controllerType)
+		if err != nil {
+			return nil, err
+		}
+		if clusterTime == nil {
+			continue
+		}
+
+		var peerTime *stdtime.Time
+		if ct.controllerType == ramenapi.DRHubType {
+			hubTime = clusterTime
+		} else {
+			peerTime = hubTime
+		}
+
+		clocks = append(clocks, report.ClusterClockSummary{
+			Cluster: ct.cluster.Name,
+			Time:    clusterTime,
+			Skew:    c.validatedClockSkew(*clusterTime, peerTime),
+		})
+	}
+
+	return clocks, nil
+}
+
+// clusterTime returns the cluster time when the ramen operator deployment was gathered, or nil if
+// the deployment was not gathered or has no cluster time.
+func (c *Command) clusterTime(
+	cluster *types.Cluster,
+	controllerType ramenapi.ControllerType,
+) (*stdtime.Time, error) {
+	log := c.Logger()
+
+	operator := ramen.Operator(c.Config(), cluster.Name, controllerType)
+	deployment, err := c.readRamenDeployment(cluster, operator.Deployment, operator.Namespace)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read deployment: %w", err)
+	}
+
+	clusterTime, err := ramen.ClusterTime(deployment.Annotations)
+	if err != nil {
+		log.Debugf("Cannot validate cluster %q clock: %s", cluster.Name, err)
+		return nil, nil
+	}
+
+	return clusterTime, nil
+}
+
+// validatedClockSkew validates the clock skew of the cluster time compared to the local host, and
+// to the hub time if hubTime is not nil.
+func (c *Command) validatedClockSkew(
+	clusterTime stdtime.Time,
+	hubTime *stdtime.Time,
+) report.ValidatedDuration {
+	threshold := c.Config().Validation.ClockSkew()
+
+	// Cluster time has a resolution of one second.
+	started := c.GatherStarted.Truncate(stdtime.Second)
+	finished := c.GatherFinished
+
+	skew := localClockSkew(clusterTime, started, finished)
+	compared := "local host"
+	if hubTime != nil {
+		peerSkew := peerClockSkew(clusterTime, *hubTime, finished.Sub(started))
+		if peerSkew > skew {
+			skew = peerSkew
+			compared = "hub"
+		}
+	}
+
+	validated := report.ValidatedDuration{Value: skew}
+	if skew > threshold {
+		validated.State = report.Warning
+		validated.Description = fmt.Sprintf("Clock skew compared to %s exceeds %s",
+			compared, threshold)
+	} else {
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// localClockSkew returns the minimal clock skew of a cluster time taken between the local times
+// started and finished.
+func localClockSkew(clusterTime, started, finished stdtime.Time) stdtime.Duration {
+	if clusterTime.Before(started) {
+		return started.Sub(clusterTime)
+	}
+	if clusterTime.After(finished) {
+		return clusterTime.Sub(finished)
+	}
+	return 0
+}
+
+// peerClockSkew returns the minimal clock skew between two cluster times taken within window.
+func peerClockSkew(clusterTime, peerTime stdtime.Time, window stdtime.Duration) stdtime.Duration {
+	diff := clusterTime.Sub(peerTime)
+	if diff < 0 {
+		diff = -diff
+	}
+	return max(diff-window, 0)
+}
@@ -28,13 +28,16 @@ type testSystem struct {
 	config     *config.Config
 	env        *types.Env
 	namespaces []string
+
+	// managedClusters are additional managed clusters.
+	managedClusters []*types.Cluster
 }
 
 var (
 	testK8s = testSystem{
 		name: "k8s",
 		config: &config.Config{
-			Namespaces: e2econfig.K8sNamespaces,
+			Namespaces: config.K8sNamespaces,
 		},
 		env: &types.Env{
 			Hub: &types.Cluster{Name: "hub"},
@@ -44,13 +47,15 @@ var (
 		namespaces: sets.Sorted([]string{
 			e2econfig.K8sNamespaces.RamenHubNamespace,
 			e2econfig.K8sNamespaces.RamenDRClusterNamespace,
+			"dr1",
+			"dr2",
 		}),
 	}
 
 	testOcp = testSystem{
 		name: "ocp",
 		config: &config.Config{
-			Namespaces: e2econfig.OcpNamespaces,
+			Namespaces: config.OcpNamespaces,
 		},
 		env: &types.Env{
 			Hub: &types.Cluster{Name: "hub"},
@@ -60,6 +65,8 @@ var (
 		namespaces: sets.Sorted([]string{
 			e2econfig.OcpNamespaces.RamenHubNamespace,
 			e2econfig.OcpNamespaces.RamenDRClusterNamespace,
+			"c1",
+			"c2",
 		}),
 	}
 )
@@ -69,7 +76,7 @@ func testCommand(
 	backend validation.Validation,
 	system testSystem,
 ) *Command {
-	cmd, err := basecmd.ForTest(CommandName, system.env, t.TempDir())
+	cmd, err := basecmd.ForTest(CommandName, system.env, t.TempDir(), system.managedClusters...)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	stdtime "time"
 
 	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
 	"github.com/ramendr/ramen/e2e/types"
@@ -88,7 +90,7 @@ func (c *Command) validateClusters() bool {
 		Cluster:    true,
 		OutputDir:  c.DataDir(),
 	}
-	if !c.GatherNamespaces(options) {
+	if !c.GatherNamespaces(c.clustersToGather(), options) {
 		return c.FinishStep()
 	}
 
@@ -104,11 +106,27 @@ func (c *Command) validateClusters() bool {
 	return true
 }
 
+// clustersToGather returns the hub, the managed clusters, and the passive hub if configured.
+func (c *Command) clustersToGather() []*types.Cluster {
+	env := c.Env()
+	clusters := append([]*types.Cluster{env.Hub}, c.ManagedClusters()...)
+	if env.PassiveHub != nil {
+		clusters = append(clusters, env.PassiveHub)
+	}
+	return clusters
+}
+
 func (c *Command) namespacesToGather() []string {
-	return sets.Sorted([]string{
-		c.Config().Namespaces.RamenHubNamespace,
-		c.Config().Namespaces.RamenDRClusterNamespace,
-	})
+	namespaces := ramen.OperatorNamespaces(c.Config())
+	// The managed cluster namespaces on the hub include the managed cluster lease and addons.
+	for _, cluster := range c.ManagedClusters() {
+		namespaces = append(namespaces, cluster.Name)
+	}
+	// The backup namespace includes the hub backup schedule, backups and restores.
+	if c.Env().PassiveHub != nil {
+		namespaces = append(namespaces, c.Config().Namespaces.OCMBackupNamespace)
+	}
+	return sets.Sorted(namespaces)
 }
 
 // checkS3Profiles inspects S3 profiles and checks access. It returns false only if the user
@@ -161,10 +179,9 @@ func (c *Command) s3Info() ([]*s3.Profile, error) {
 	// synced to managed clusters.
 	hub := c.Env().Hub
 	reader := c.OutputReader(hub.Name)
-	configMapName := ramen.HubOperatorConfigMapName
-	configMapNamespace := c.Config().Namespaces.RamenHubNamespace
+	operator := ramen.Operator(c.Config(), hub.Name, ramenapi.DRHubType)
 
-	storeProfiles, err := ramen.ClusterProfiles(reader, configMapName, configMapNamespace)
+	storeProfiles, err := ramen.ClusterProfiles(reader, operator.ConfigMap, operator.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -218,6 +235,50 @@ func (c *Command) validateGatheredData() bool {
 		return false
 	}
 
+	versions, err := c.validateVersions()
+	if err != nil {
+		step.Status = report.Failed
+		step.Err = "Failed to validate versions"
+		msg := "Failed to validate versions"
+		console.Error(msg)
+		log.Errorf("%s: %s", msg, err)
+		return false
+	}
+	s.Versions = versions
+
+	clocks, err := c.validateClocks()
+	if err != nil {
+		step.Status = report.Failed
+		step.Err = "Failed to validate clocks"
+		msg := "Failed to validate clocks"
+		console.Error(msg)
+		log.Errorf("%s: %s", msg, err)
+		return false
+	}
+	s.Clocks = clocks
+
+	ramenConfig, err := c.validateRamenConfigs()
+	if err != nil {
+		step.Status = report.Failed
+		step.Err = "Failed to validate ramen config"
+		msg := "Failed to validate ramen config"
+		console.Error(msg)
+		log.Errorf("%s: %s", msg, err)
+		return false
+	}
+	s.RamenConfig = ramenConfig
+
+	passiveHub, err := c.validatePassiveHub()
+	if err != nil {
+		step.Status = report.Failed
+		step.Err = "Failed to validate passive hub"
+		msg := "Failed to validate passive hub"
+		console.Error(msg)
+		log.Errorf("%s: %s", msg, err)
+		return false
+	}
+	s.PassiveHub = passiveHub
+
 	c.validateS3Status(&s.S3)
 
 	if summary.HasIssues(c.Report.Summary) {
@@ -244,8 +305,7 @@ func (c *Command) validateHub(s *report.ClustersStatusHub) error {
 	}
 
 	hub := c.Env().Hub
-	namespace := c.Config().Namespaces.RamenHubNamespace
-	if err := c.validateRamen(&s.Ramen, hub, namespace, ramenapi.DRHubType); err != nil {
+	if err := c.validateRamen(&s.Ramen, hub, ramenapi.DRHubType); err != nil {
 		return fmt.Errorf("failed to validate ramen: %w", err)
 	}
 
@@ -270,11 +330,25 @@ func (c *Command) validateDRPolicies(
 		}
 
 		log.Debugf("Read drpolicy %q", drPolicy.Name)
+		metro, err := ramen.IsMetroDRPolicy(reader, drPolicy)
+		if err != nil {
+			return fmt.Errorf("failed to inspect drpolicy %q: %w", policyName, err)
+		}
+		mode := report.RegionalDR
+		if metro {
+			mode = report.MetroDR
+		}
+		peerClasses, err := c.validatedPeerClasses(drPolicy, metro)
+		if err != nil {
+			return fmt.Errorf("failed to validate drpolicy %q peer classes: %w", policyName, err)
+		}
 		dps := report.DRPolicySummary{
 			Name:               drPolicy.Name,
+			Mode:               mode,
 			SchedulingInterval: drPolicy.Spec.SchedulingInterval,
 			DRClusters:         drPolicy.Spec.DRClusters,
-			PeerClasses:        c.validatedPeerClasses(drPolicy),
+			Clusters:           c.validatedDRPolicyClusters(drPolicy),
+			PeerClasses:        peerClasses,
 			Conditions:         c.ValidatedConditions(drPolicy, drPolicy.Status.Conditions),
 		}
 		drPoliciesList.Value = append(drPoliciesList.Value, dps)
@@ -292,16 +366,61 @@ func (c *Command) validateDRPolicies(
 	return nil
 }
 
+// validatedDRPolicyClusters validates that the DRPolicy clusters are configured. We gather data
+// only from the configured clusters, so we cannot validate the other DRPolicy clusters.
+func (c *Command) validatedDRPolicyClusters(drPolicy *ramenapi.DRPolicy) report.ValidatedBool {
+	var missing []string
+	for _, name := range drPolicy.Spec.DRClusters {
+		if !c.isManagedCluster(name) {
+			missing = append(missing, name)
+		}
+	}
+
+	validated := report.ValidatedBool{}
+	if len(missing) > 0 {
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("Clusters not configured: %s",
+			strings.Join(missing, ", "))
+	} else {
+		validated.Value = true
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// isManagedCluster returns true if name is a configured managed cluster.
+func (c *Command) isManagedCluster(name string) bool {
+	return slices.ContainsFunc(c.ManagedClusters(), func(cluster *types.Cluster) bool {
+		return cluster.Name == name
+	})
+}
+
+// validatedPeerClasses validates the DRPolicy peer classes. Metro DR requires peered storage
+// replicating synchronously, reported in the sync peer classes.
 func (c *Command) validatedPeerClasses(
 	drPolicy *ramenapi.DRPolicy,
-) report.ValidatedPeerClassesList {
+	metro bool,
+) (report.ValidatedPeerClassesList, error) {
 	peerClassesList := report.ValidatedPeerClassesList{}
 
-	for _, peerClass := range drPolicy.Status.Async.PeerClasses {
+	peerClasses := drPolicy.Status.Async.PeerClasses
+	if metro {
+		peerClasses = drPolicy.Status.Sync.PeerClasses
+	}
+
+	for i := range peerClasses {
+		peerClass := &peerClasses[i]
+		clusters, err := c.validatedPeerClassClusters(drPolicy, peerClass)
+		if err != nil {
+			return peerClassesList, err
+		}
 		pcs := report.PeerClassesSummary{
 			StorageClassName: peerClass.StorageClassName,
 			ReplicationID:    peerClass.ReplicationID,
 			Grouping:         peerClass.Grouping,
+			Clusters:         clusters,
 		}
 		peerClassesList.Value = append(peerClassesList.Value, pcs)
 	}
@@ -314,7 +433,7 @@ func (c *Command) validatedPeerClasses(
 	}
 	summary.AddValidation(c.Report.Summary, &peerClassesList)
 
-	return peerClassesList
+	return peerClassesList, nil
 }
 
 func (c *Command) validateDRClusters(
@@ -328,6 +447,17 @@ func (c *Command) validateDRClusters(
 		return fmt.Errorf("failed to list drclusters: %w", err)
 	}
 
+	hubProfiles, err := c.hubS3ProfileNames()
+	if err != nil {
+		return fmt.Errorf("failed to read hub s3 profiles: %w", err)
+	}
+
+	metroClusters, err := c.metroDRClusters()
+	if err != nil {
+		return err
+	}
+
+	var drClusters []*ramenapi.DRCluster
 	for _, drClusterName := range drClusterNames {
 		drCluster, err := ramen.ReadDRCluster(reader, drClusterName)
 		if err != nil {
@@ -335,12 +465,43 @@ func (c *Command) validateDRClusters(
 		}
 
 		log.Debugf("Read drcluster %q", drCluster.Name)
+		networkFences, err := c.validatedNetworkFences(drCluster)
+		if err != nil {
+			return fmt.Errorf("failed to validate drcluster %q network fences: %w",
+				drCluster.Name, err)
+		}
+		maintenanceModes, err := c.validatedMaintenanceModes(drCluster)
+		if err != nil {
+			return fmt.Errorf("failed to validate drcluster %q maintenance modes: %w",
+				drCluster.Name, err)
+		}
 		dcs := report.DRClusterSummary{
-			Name:       drCluster.Name,
-			Phase:      string(drCluster.Status.Phase),
-			Conditions: c.validatedDRClusterConditions(drCluster),
+			Name:             drCluster.Name,
+			Phase:            string(drCluster.Status.Phase),
+			Region:           string(drCluster.Spec.Region),
+			S3Profile:        c.validatedDRClusterS3Profile(drCluster, hubProfiles),
+			FencingReady:     c.validatedFencingReady(drCluster, metroClusters),
+			NetworkFences:    networkFences,
+			MaintenanceModes: maintenanceModes,
+			Conditions:       c.validatedDRClusterConditions(drCluster),
 		}
 		drClustersList.Value = append(drClustersList.Value, dcs)
+		drClusters = append(drClusters, drCluster)
+	}
+
+	// Fencing is validated with the peer clusters state.
+	peerNames, err := c.drClusterPeers()
+	if err != nil {
+		return err
+	}
+	for i, drCluster := range drClusters {
+		var peers []drClusterPeer
+		for j, peer := range drClusters {
+			if slices.Contains(peerNames[drCluster.Name], peer.Name) {
+				peers = append(peers, drClusterPeer{peer, &drClustersList.Value[j]})
+			}
+		}
+		drClustersList.Value[i].ClusterFence = c.validatedClusterFence(drCluster, peers)
 	}
 
 	if len(drClustersList.Value) < 2 {
@@ -380,17 +541,14 @@ func (c *Command) validatedDRClusterConditions(
 }
 
 func (c *Command) validateManagedClusters(s *[]report.ClustersStatusCluster) error {
-	env := c.Env()
-	namespace := c.Config().Namespaces.RamenDRClusterNamespace
-
-	for _, cluster := range env.ManagedClusters() {
+	for _, cluster := range c.ManagedClusters() {
 		cs := report.ClustersStatusCluster{Name: cluster.Name}
-		if err := c.validateRamen(
-			&cs.Ramen,
-			cluster,
-			namespace,
-			ramenapi.DRClusterType,
-		); err != nil {
+		managedCluster, err := c.validatedManagedCluster(cluster)
+		if err != nil {
+			return fmt.Errorf("failed to validate managed cluster: %w", err)
+		}
+		cs.ManagedCluster = managedCluster
+		if err := c.validateRamen(&cs.Ramen, cluster, ramenapi.DRClusterType); err != nil {
 			return fmt.Errorf("failed to validate ramen: %w", err)
 		}
 		*s = append(*s, cs)
@@ -402,11 +560,12 @@ func (c *Command) validateManagedClusters(s *[]report.ClustersStatusCluster) err
 func (c *Command) validateRamen(
 	s *report.RamenSummary,
 	cluster *types.Cluster,
-	namespace string,
 	controllerType ramenapi.ControllerType,
 ) error {
-	deploymentName := ramen.OperatorDeploymentName(controllerType)
-	configMapName := ramen.OperatorConfigMapName(controllerType)
+	operator := ramen.Operator(c.Config(), cluster.Name, controllerType)
+	deploymentName := operator.Deployment
+	configMapName := operator.ConfigMap
+	namespace := operator.Namespace
 
 	deployment, err := c.readRamenDeployment(cluster, deploymentName, namespace)
 	if err != nil && !errors.Is(err, os.ErrNotExist) {
@@ -418,7 +577,7 @@ func (c *Command) validateRamen(
 		deploymentName,
 		namespace,
 		deployment,
-		ramen.OperatorReplicas,
+		operator.Replicas,
 	)
 
 	configMap, err := c.readRamenConfigMap(cluster, configMapName, namespace)
@@ -511,9 +670,6 @@ func (c *Command) validateRamenConfigMap(
 		}
 	}
 
-	// TODO: Validate that configmap is identical to the configmap on the hub except the controller
-	// type.
-
 	return nil
 }
 
@@ -606,6 +762,7 @@ func (c *Command) validatedHubS3Profiles(
 			S3CompatibleEndpoint: c.validatedRequiredString(profile.S3CompatibleEndpoint),
 			S3Region:             c.validatedRequiredString(profile.S3Region),
 			CACertificate:        c.validatedCertificateFingerprint(profile.CACertificates),
+			CACertificates:       c.validatedCertificates(profile.CACertificates),
 			S3SecretRef:          validatedSecret,
 		}
 		s.Value = append(s.Value, ps)
@@ -665,7 +822,8 @@ func (c *Command) validatedManagedClusterS3Profiles(
 				hubS3Profile.CACertificate,
 				found,
 			),
-			S3SecretRef: validatedSecret,
+			CACertificates: c.validatedCertificates(profile.CACertificates),
+			S3SecretRef:    validatedSecret,
 		}
 		s.Value = append(s.Value, ps)
 	}
@@ -761,6 +919,61 @@ func (c *Command) validatedCertificateFingerprint(certPem []byte) report.Validat
 	return validated
 }
 
+// validatedCertificates validates the validity window of all certificates in the CA bundle.
+// Invalid bundles are reported when validating the certificate fingerprint.
+func (c *Command) validatedCertificates(certPem []byte) []report.CertificateSummary {
+	if len(certPem) == 0 {
+		return nil
+	}
+
+	certs, err := s3.ParseCertificates(certPem)
+	if err != nil {
+		return nil
+	}
+
+	now := time.Now()
+	expiry := c.Config().S3.CertificateExpiry()
+
+	var summaries []report.CertificateSummary
+	for _, cert := range certs {
+		cs := report.CertificateSummary{
+			Subject:   cert.Subject.String(),
+			Issuer:    cert.Issuer.String(),
+			NotBefore: &cert.NotBefore,
+			NotAfter:  c.validatedCertificateNotAfter(cert.NotBefore, cert.NotAfter, now, expiry),
+		}
+		summaries = append(summaries, cs)
+	}
+
+	return summaries
+}
+
+func (c *Command) validatedCertificateNotAfter(
+	notBefore, notAfter, now time.Time,
+	expiry stdtime.Duration,
+) report.ValidatedTime {
+	validated := report.ValidatedTime{Value: &notAfter}
+
+	switch {
+	case now.Before(notBefore):
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("Certificate is not valid before %s",
+			notBefore.Format(stdtime.RFC3339))
+	case !now.Before(notAfter):
+		validated.State = report.Problem
+		validated.Description = "Certificate has expired"
+	case notAfter.Sub(now) < expiry:
+		validated.State = report.Warning
+		validated.Description = fmt.Sprintf("Certificate expires in %d days",
+			int(notAfter.Sub(now).Hours()/24))
+	default:
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
 func (c *Command) validatedManagedClusterCertificateFingerprint(
 	certPem []byte,
 	hubValue report.ValidatedFingerprint,
@@ -1156,7 +1369,8 @@ func (c *Command) validatedS3ProfileStatus(s *report.ValidatedClustersS3ProfileS
 
 func (c *Command) validatedS3Profile(result s3.Result) report.ClustersS3ProfileStatus {
 	profileStatus := report.ClustersS3ProfileStatus{
-		Name: result.ProfileName,
+		Name:      result.ProfileName,
+		Transport: report.NewS3TransportSummary(c.Config().S3.Transport(result.ProfileName)),
 	}
 
 	if result.Err != nil {
@@ -5,6 +5,7 @@ package clusters
 
 import (
 	"testing"
+	stdtime "time"
 
 	"github.com/ramendr/ramenctl/pkg/helpers"
 	"github.com/ramendr/ramenctl/pkg/report"
@@ -71,10 +72,12 @@ func TestValidateClustersK8s(t *testing.T) {
 	expected := loadClustersStatus(t, "k8s-status.yaml")
 	checkClusterStatus(t, validate.Report, expected)
 
-	checkSummary(t, validate.Report, report.Summary{summary.OK: 93})
+	checkSummary(t, validate.Report, report.Summary{summary.OK: 160})
 }
 
 func TestValidateClustersOcp(t *testing.T) {
+	// The S3 profiles CA certificate is valid from 2026-01-16 to 2027-01-16.
+	helpers.FakeTimeAt(t, stdtime.Date(2026, 2, 1, 0, 0, 0, 0, stdtime.UTC))
 	validate := testCommand(t, &helpers.ValidationMock{}, testOcp)
 	helpers.AddGatheredData(t, validate.DataDir(), ocpTestdata, validate.Report.Name)
 	if err := validate.Run(); err != nil {
@@ -117,7 +120,7 @@ func TestValidateClustersOcp(t *testing.T) {
 	expected := loadClustersStatus(t, "ocp-status.yaml")
 	checkClusterStatus(t, validate.Report, expected)
 
-	checkSummary(t, validate.Report, report.Summary{summary.OK: 91})
+	checkSummary(t, validate.Report, report.Summary{summary.OK: 163})
 }
 
 func TestValidateClustersValidateFailed(t *testing.T) {
@@ -336,11 +339,11 @@ func TestValidateClustersGetSecretFailed(t *testing.T) {
 		{
 			Name:   "validate clusters data",
 			Status: report.Failed,
-			Err:    "Validation failed (91 ok, 0 warning, 2 problem)",
+			Err:    "Validation failed (158 ok, 0 warning, 2 problem)",
 		},
 	}
 	checkItems(t, validate.Report.Steps[1], items)
-	checkSummary(t, validate.Report, report.Summary{summary.OK: 91, summary.Problem: 2})
+	checkSummary(t, validate.Report, report.Summary{summary.OK: 158, summary.Problem: 2})
 }
 
 func TestValidateClustersGetSecretInvalid(t *testing.T) {
@@ -389,11 +392,11 @@ func TestValidateClustersGetSecretInvalid(t *testing.T) {
 		{
 			Name:   "validate clusters data",
 			Status: report.Failed,
-			Err:    "Validation failed (91 ok, 0 warning, 2 problem)",
+			Err:    "Validation failed (158 ok, 0 warning, 2 problem)",
 		},
 	}
 	checkItems(t, validate.Report.Steps[1], items)
-	checkSummary(t, validate.Report, report.Summary{summary.OK: 91, summary.Problem: 2})
+	checkSummary(t, validate.Report, report.Summary{summary.OK: 158, summary.Problem: 2})
 }
 
 func TestValidateClustersCheckS3Failed(t *testing.T) {
@@ -436,7 +439,7 @@ func TestValidateClustersCheckS3Failed(t *testing.T) {
 		{
 			Name:   "validate clusters data",
 			Status: report.Failed,
-			Err:    "Validation failed (92 ok, 0 warning, 1 problem)",
+			Err:    "Validation failed (159 ok, 0 warning, 1 problem)",
 		},
 	}
 	checkItems(t, validate.Report.Steps[1], items)
@@ -447,7 +450,7 @@ func TestValidateClustersCheckS3Failed(t *testing.T) {
 	checkSummary(
 		t,
 		validate.Report,
-		report.Summary{summary.OK: 92, summary.Problem: 1},
+		report.Summary{summary.OK: 159, summary.Problem: 1},
 	)
 }
 
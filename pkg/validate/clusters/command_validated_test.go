@@ -5,15 +5,23 @@ package clusters
 
 import (
 	"fmt"
+	"slices"
 	"testing"
+	stdtime "time"
 
 	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/e2e/types"
 	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/ramendr/ramenctl/pkg/helpers"
+	"github.com/ramendr/ramenctl/pkg/ocm"
 	"github.com/ramendr/ramenctl/pkg/ramen"
 	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/storage"
 	"github.com/ramendr/ramenctl/pkg/validate/summary"
 )
 
@@ -225,6 +233,731 @@ func TestValidateControllerTypeCorruptedConfigMapLegacyDeployment(t *testing.T)
 	}
 }
 
+func TestValidatedCertificateNotAfter(t *testing.T) {
+	notBefore := stdtime.Date(2026, 1, 16, 0, 0, 0, 0, stdtime.UTC)
+	notAfter := stdtime.Date(2027, 1, 16, 0, 0, 0, 0, stdtime.UTC)
+	expiry := 30 * 24 * stdtime.Hour
+
+	tests := []struct {
+		name  string
+		now   stdtime.Time
+		state report.ValidationState
+	}{
+		{name: "valid", now: notAfter.Add(-60 * 24 * stdtime.Hour), state: report.OK},
+		{name: "expires soon", now: notAfter.Add(-10 * 24 * stdtime.Hour), state: report.Warning},
+		{name: "expired", now: notAfter.Add(stdtime.Hour), state: report.Problem},
+		{name: "not valid yet", now: notBefore.Add(-stdtime.Hour), state: report.Problem},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedCertificateNotAfter(notBefore, notAfter, tt.now, expiry)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+			if validated.Value == nil || !validated.Value.Equal(notAfter) {
+				t.Errorf("expected value %v, got %v", notAfter, validated.Value)
+			}
+		})
+	}
+}
+
+func TestValidatedOperatorVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		hubVersion string
+		state      report.ValidationState
+	}{
+		{name: "same version", version: "4.20.0", hubVersion: "4.20.0", state: report.OK},
+		{name: "different version", version: "4.19.0", hubVersion: "4.20.0", state: report.Problem},
+		{name: "unknown hub version", version: "4.20.0", hubVersion: "", state: report.OK},
+		{name: "unknown version", version: "", hubVersion: "4.20.0", state: report.Warning},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedOperatorVersion(tt.version, tt.hubVersion)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+			if validated.Value != tt.version {
+				t.Errorf("expected value %q, got %q", tt.version, validated.Value)
+			}
+		})
+	}
+}
+
+func TestValidatedClockSkew(t *testing.T) {
+	started := stdtime.Date(2026, 2, 1, 0, 0, 0, 0, stdtime.UTC)
+	finished := started.Add(10 * stdtime.Second)
+	at := func(d stdtime.Duration) *stdtime.Time {
+		t := started.Add(d)
+		return &t
+	}
+	tests := []struct {
+		name        string
+		clusterTime *stdtime.Time
+		hubTime     *stdtime.Time
+		skew        stdtime.Duration
+		state       report.ValidationState
+	}{
+		{name: "during gather", clusterTime: at(5 * stdtime.Second), state: report.OK},
+		{name: "small skew", clusterTime: at(-30 * stdtime.Second), skew: 30 * stdtime.Second,
+			state: report.OK},
+		{name: "behind local host", clusterTime: at(-2 * stdtime.Minute), skew: 2 * stdtime.Minute,
+			state: report.Warning},
+		{name: "ahead of local host", clusterTime: at(3 * stdtime.Minute),
+			skew: 3*stdtime.Minute - 10*stdtime.Second, state: report.Warning},
+		{name: "same as hub", clusterTime: at(0), hubTime: at(10 * stdtime.Second),
+			state: report.OK},
+		{name: "ahead of hub", clusterTime: at(2 * stdtime.Minute),
+			hubTime: at(-2 * stdtime.Minute), skew: 4*stdtime.Minute - 10*stdtime.Second,
+			state: report.Warning},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			cmd.GatherStarted = started
+			cmd.GatherFinished = finished
+			validated := cmd.validatedClockSkew(*tt.clusterTime, tt.hubTime)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+			if validated.Value != tt.skew {
+				t.Errorf("expected skew %v, got %v", tt.skew, validated.Value)
+			}
+		})
+	}
+}
+
+func TestValidatedRamenConfigField(t *testing.T) {
+	tests := []struct {
+		name     string
+		mismatch report.ValidationState
+		value    string
+		state    report.ValidationState
+	}{
+		{name: "same value", mismatch: report.Problem, value: "50", state: report.OK},
+		{name: "different value", mismatch: report.Problem, value: "10", state: report.Problem},
+		{name: "different hub only value", mismatch: report.Warning, value: "10",
+			state: report.Warning},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			field := &ramenConfigField{name: "field", mismatch: tt.mismatch}
+			validated := cmd.validatedRamenConfigField(field, tt.value, "50")
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+			if validated.Value != tt.value {
+				t.Errorf("expected value %q, got %q", tt.value, validated.Value)
+			}
+		})
+	}
+}
+
+func TestValidatedHubRamenConfigField(t *testing.T) {
+	automation := &ramenapi.RamenConfig{}
+	automation.DrClusterOperator.DeploymentAutomationEnabled = true
+	tests := []struct {
+		name   string
+		field  string
+		value  string
+		config *ramenapi.RamenConfig
+		state  report.ValidationState
+	}{
+		{name: "ops namespace", field: "ramenOpsNamespace", value: "ramen-ops",
+			config: automation, state: report.OK},
+		{name: "wrong ops namespace", field: "ramenOpsNamespace", value: "my-ops",
+			config: automation, state: report.Problem},
+		{name: "dr cluster namespace", field: "drClusterOperator.namespaceName",
+			value: "ramen-system", config: automation, state: report.OK},
+		{name: "wrong dr cluster namespace", field: "drClusterOperator.namespaceName",
+			value: "my-ramen", config: automation, state: report.Problem},
+		{name: "dr cluster namespace without automation",
+			field: "drClusterOperator.namespaceName", value: "my-ramen",
+			config: &ramenapi.RamenConfig{}},
+		{name: "not validated", field: "maxConcurrentReconciles", value: "50",
+			config: automation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedHubRamenConfigField(tt.field, tt.value, tt.config)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+		})
+	}
+}
+
+func TestValidatedClusterFence(t *testing.T) {
+	drCluster := func(name string, fence ramenapi.ClusterFenceState) *ramenapi.DRCluster {
+		d := &ramenapi.DRCluster{}
+		d.Name = name
+		d.Spec.ClusterFence = fence
+		return d
+	}
+	maintenance := &report.DRClusterSummary{
+		Name:             "dr2",
+		MaintenanceModes: []report.MaintenanceModeSummary{{Name: "mode"}},
+	}
+	tests := []struct {
+		name  string
+		fence ramenapi.ClusterFenceState
+		peer  drClusterPeer
+		state report.ValidationState
+	}{
+		{name: "unfenced", fence: "",
+			peer:  drClusterPeer{drCluster("dr2", ""), &report.DRClusterSummary{}},
+			state: report.OK},
+		{name: "fenced", fence: ramenapi.ClusterFenceStateFenced,
+			peer:  drClusterPeer{drCluster("dr2", ""), &report.DRClusterSummary{}},
+			state: report.Warning},
+		{name: "peer fenced", fence: ramenapi.ClusterFenceStateManuallyFenced,
+			peer: drClusterPeer{drCluster("dr2", ramenapi.ClusterFenceStateFenced),
+				&report.DRClusterSummary{}},
+			state: report.Problem},
+		{name: "peer in maintenance", fence: ramenapi.ClusterFenceStateFenced,
+			peer:  drClusterPeer{drCluster("dr2", ""), maintenance},
+			state: report.Problem},
+		{name: "unfenced peer in maintenance", fence: ramenapi.ClusterFenceStateUnfenced,
+			peer:  drClusterPeer{drCluster("dr2", ""), maintenance},
+			state: report.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedClusterFence(drCluster("dr1", tt.fence),
+				[]drClusterPeer{tt.peer})
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+			if validated.Value != string(tt.fence) {
+				t.Errorf("expected value %q, got %q", tt.fence, validated.Value)
+			}
+		})
+	}
+}
+
+func TestValidatedFencingReady(t *testing.T) {
+	drCluster := func(cidrs ...string) *ramenapi.DRCluster {
+		d := &ramenapi.DRCluster{}
+		d.Name = "dr1"
+		d.Spec.CIDRs = cidrs
+		return d
+	}
+
+	t.Run("regional", func(t *testing.T) {
+		cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+		if validated := cmd.validatedFencingReady(drCluster(), nil); validated != nil {
+			t.Errorf("expected nil, got %+v", validated)
+		}
+	})
+
+	tests := []struct {
+		name  string
+		cidrs []string
+		state report.ValidationState
+	}{
+		{name: "metro with cidrs", cidrs: []string{"192.168.1.0/24"}, state: report.OK},
+		{name: "metro without cidrs", state: report.Problem},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedFencingReady(drCluster(tt.cidrs...), []string{"dr1", "dr2"})
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+			if validated.Value != (len(tt.cidrs) > 0) {
+				t.Errorf("unexpected value %v", validated.Value)
+			}
+		})
+	}
+}
+
+func TestValidatedBackupSchedulePhase(t *testing.T) {
+	schedule := func(phase string) *ocm.BackupSchedule {
+		return &ocm.BackupSchedule{Status: ocm.BackupScheduleStatus{Phase: phase}}
+	}
+	tests := []struct {
+		name     string
+		schedule *ocm.BackupSchedule
+		state    report.ValidationState
+	}{
+		{name: "missing", state: report.Problem},
+		{name: "enabled", schedule: schedule(ocm.BackupSchedulePhaseEnabled), state: report.OK},
+		{name: "failed", schedule: schedule("FailedValidation"), state: report.Problem},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedBackupSchedulePhase(tt.schedule)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+		})
+	}
+}
+
+func TestValidatedLastBackupTime(t *testing.T) {
+	hubTime := stdtime.Date(2026, 2, 1, 12, 0, 0, 0, stdtime.UTC)
+	at := func(d stdtime.Duration) *stdtime.Time {
+		t := hubTime.Add(d)
+		return &t
+	}
+	tests := []struct {
+		name           string
+		lastBackupTime *stdtime.Time
+		hubTime        *stdtime.Time
+		state          report.ValidationState
+	}{
+		{name: "no backup", hubTime: &hubTime, state: report.Problem},
+		{name: "recent", lastBackupTime: at(-stdtime.Hour), hubTime: &hubTime, state: report.OK},
+		{name: "at threshold", lastBackupTime: at(-24 * stdtime.Hour), hubTime: &hubTime,
+			state: report.OK},
+		{name: "stale", lastBackupTime: at(-25 * stdtime.Hour), hubTime: &hubTime,
+			state: report.Warning},
+		{name: "unknown hub time", lastBackupTime: at(-25 * stdtime.Hour), state: report.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedLastBackupTime(tt.lastBackupTime, tt.hubTime)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+			if tt.lastBackupTime != nil && !validated.Value.Equal(*tt.lastBackupTime) {
+				t.Errorf("expected time %v, got %v", tt.lastBackupTime, validated.Value)
+			}
+		})
+	}
+}
+
+func TestValidatedLastBackupTimeUTC(t *testing.T) {
+	local := stdtime.Date(2026, 2, 1, 12, 0, 0, 0, stdtime.FixedZone("UTC+2", 2*60*60))
+	cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+	validated := cmd.validatedLastBackupTime(&local, nil)
+	if validated.Value.Location() != stdtime.UTC {
+		t.Fatalf("expected time in UTC, got %v", validated.Value)
+	}
+	if !validated.Value.Equal(local) {
+		t.Fatalf("expected time %v, got %v", local, validated.Value)
+	}
+}
+
+func TestValidatedRestorePhase(t *testing.T) {
+	restore := func(phase string, sync bool) *ocm.Restore {
+		r := &ocm.Restore{}
+		r.Spec.SyncRestoreWithNewBackups = sync
+		r.Status.Phase = phase
+		return r
+	}
+	tests := []struct {
+		name    string
+		restore *ocm.Restore
+		state   report.ValidationState
+	}{
+		{name: "missing", state: report.Problem},
+		{name: "syncing", restore: restore(ocm.RestorePhaseEnabled, true), state: report.OK},
+		{name: "finished", restore: restore(ocm.RestorePhaseFinished, false), state: report.Warning},
+		{name: "failed", restore: restore("Error", true), state: report.Problem},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedRestorePhase(tt.restore)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+		})
+	}
+}
+
+func TestValidatedSameS3Profiles(t *testing.T) {
+	profile := func(name, bucket string) ramenapi.S3StoreProfile {
+		return ramenapi.S3StoreProfile{S3ProfileName: name, S3Bucket: bucket}
+	}
+	hubProfiles := []ramenapi.S3StoreProfile{profile("p1", "bucket"), profile("p2", "bucket")}
+	tests := []struct {
+		name        string
+		profiles    []ramenapi.S3StoreProfile
+		state       report.ValidationState
+		description string
+	}{
+		{
+			name:     "same",
+			profiles: []ramenapi.S3StoreProfile{profile("p1", "bucket"), profile("p2", "bucket")},
+			state:    report.OK,
+		},
+		{
+			name:        "missing",
+			profiles:    []ramenapi.S3StoreProfile{profile("p1", "bucket")},
+			state:       report.Problem,
+			description: `S3 profiles differ from hub: missing ["p2"]`,
+		},
+		{
+			name: "different and not in hub",
+			profiles: []ramenapi.S3StoreProfile{
+				profile("p1", "other"),
+				profile("p2", "bucket"),
+				profile("p3", "bucket"),
+			},
+			state:       report.Problem,
+			description: `S3 profiles differ from hub: different ["p1"], not in hub ["p3"]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedSameS3Profiles(hubProfiles, tt.profiles)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q", tt.state, validated.State)
+			}
+			if validated.Description != tt.description {
+				t.Errorf("expected description %q, got %q", tt.description, validated.Description)
+			}
+		})
+	}
+}
+
+func TestValidatedCRDStorageVersion(t *testing.T) {
+	apiVersion := ramenapi.GroupVersion.Version
+	tests := []struct {
+		name           string
+		storageVersion string
+		servedVersions []string
+		state          report.ValidationState
+	}{
+		{
+			name:           "api version",
+			storageVersion: apiVersion,
+			servedVersions: []string{apiVersion},
+			state:          report.OK,
+		},
+		{
+			name:           "api version not served",
+			storageVersion: "v1",
+			servedVersions: []string{"v1"},
+			state:          report.Problem,
+		},
+		{
+			name:           "other storage version",
+			storageVersion: "v1",
+			servedVersions: []string{apiVersion, "v1"},
+			state:          report.Warning,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedCRDStorageVersion(tt.storageVersion, tt.servedVersions)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+		})
+	}
+}
+
+func TestValidatedCRDSchemaMissingFields(t *testing.T) {
+	cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+	version := &ramen.CRDVersion{
+		Name: ramenapi.GroupVersion.Version,
+		Schema: &ramen.CRDVersionSchema{
+			OpenAPIV3Schema: &ramen.CRDSchema{
+				Properties: map[string]ramen.CRDSchema{
+					"spec":   {Properties: map[string]ramen.CRDSchema{}},
+					"status": {Properties: map[string]ramen.CRDSchema{}},
+				},
+			},
+		},
+	}
+	validated := cmd.validatedCRDSchema("drpolicies.ramendr.openshift.io", version)
+	if validated.State != report.Problem {
+		t.Errorf("expected state %q, got %q", report.Problem, validated.State)
+	}
+	if validated.Value {
+		t.Error("schema with missing fields validated")
+	}
+}
+
+func TestValidatedPeerClassStorageClass(t *testing.T) {
+	peerClass := &ramenapi.PeerClass{
+		StorageClassName: "rook-ceph-block",
+		StorageID:        []string{"rook-ceph-block-dr1-1", "rook-ceph-block-dr2-1"},
+	}
+	tests := []struct {
+		name         string
+		storageClass *storagev1.StorageClass
+		state        report.ValidationState
+	}{
+		{
+			name:         "matching storage id",
+			storageClass: testStorageClass("rook-ceph-block-dr1-1"),
+			state:        report.OK,
+		},
+		{
+			name:         "other storage id",
+			storageClass: testStorageClass("rook-ceph-block-dr3-1"),
+			state:        report.Problem,
+		},
+		{
+			name:         "no storage id",
+			storageClass: testStorageClass(""),
+			state:        report.Problem,
+		},
+		{
+			name:         "missing storage class",
+			storageClass: nil,
+			state:        report.Problem,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedPeerClassStorageClass(tt.storageClass, peerClass)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+		})
+	}
+}
+
+func TestValidatedDRPolicyClusters(t *testing.T) {
+	system := testK8s
+	system.managedClusters = []*types.Cluster{{Name: "dr3"}}
+	tests := []struct {
+		name        string
+		clusters    []string
+		state       report.ValidationState
+		description string
+	}{
+		{
+			name:     "configured clusters",
+			clusters: []string{"dr1", "dr2"},
+			state:    report.OK,
+		},
+		{
+			name:     "third managed cluster",
+			clusters: []string{"dr1", "dr3"},
+			state:    report.OK,
+		},
+		{
+			name:        "cluster not configured",
+			clusters:    []string{"dr1", "dr4"},
+			state:       report.Problem,
+			description: "Clusters not configured: dr4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, system)
+			drPolicy := &ramenapi.DRPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "dr-policy"},
+				Spec:       ramenapi.DRPolicySpec{DRClusters: tt.clusters},
+			}
+			validated := cmd.validatedDRPolicyClusters(drPolicy)
+			expected := report.ValidatedBool{
+				Validated: report.Validated{State: tt.state, Description: tt.description},
+				Value:     tt.state == report.OK,
+			}
+			if validated != expected {
+				t.Errorf("expected %+v, got %+v", expected, validated)
+			}
+		})
+	}
+}
+
+func TestValidatedPeerClassClustersNotConfigured(t *testing.T) {
+	cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+	helpers.AddGatheredData(t, cmd.DataDir(), k8sTestdata, cmd.Report.Name)
+
+	// A second DRPolicy using the configured cluster dr1 and the unknown cluster dr3.
+	drPolicy := &ramenapi.DRPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "dr-policy-dr3"},
+		Spec:       ramenapi.DRPolicySpec{DRClusters: []string{"dr1", "dr3"}},
+	}
+	peerClass := &ramenapi.PeerClass{
+		StorageClassName: "rook-cephfs-fs1",
+		StorageID:        []string{"rook-cephfs-fs1-dr1-1", "rook-cephfs-fs1-dr3-1"},
+	}
+
+	clusters, err := cmd.validatedPeerClassClusters(drPolicy, peerClass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []report.PeerClassClusterSummary{
+		{
+			Name: "dr1",
+			StorageClass: report.ValidatedString{
+				Validated: report.Validated{State: report.OK},
+				Value:     "rook-cephfs-fs1-dr1-1",
+			},
+		},
+		{
+			Name: "dr3",
+			StorageClass: report.ValidatedString{
+				Validated: report.Validated{
+					State:       report.Problem,
+					Description: "Cluster not configured",
+				},
+			},
+		},
+	}
+	if !slices.EqualFunc(clusters, expected, func(a, b report.PeerClassClusterSummary) bool {
+		return a.Equal(&b)
+	}) {
+		t.Fatalf("expected clusters %+v, got %+v", expected, clusters)
+	}
+
+	expectedSummary := report.Summary{summary.OK: 1, summary.Problem: 1}
+	if !cmd.Report.Summary.Equal(&expectedSummary) {
+		t.Errorf("expected summary %v, got %v", expectedSummary, *cmd.Report.Summary)
+	}
+}
+
+func TestValidatedPeerClassReplicationClass(t *testing.T) {
+	peerClass := &ramenapi.PeerClass{
+		StorageClassName: "rook-ceph-block",
+		ReplicationID:    "rook-ceph-replication-1",
+	}
+	storageClass := testStorageClass("rook-ceph-block-dr1-1")
+	provisioner := storageClass.Provisioner
+	tests := []struct {
+		name    string
+		classes []*storage.ReplicationClass
+		value   string
+		state   report.ValidationState
+	}{
+		{
+			name: "matching class",
+			classes: []*storage.ReplicationClass{
+				testReplicationClass("vrc-1m", provisioner, peerClass.ReplicationID, "1m"),
+				testReplicationClass("vrc-5m", provisioner, peerClass.ReplicationID, "5m"),
+			},
+			value: "vrc-5m",
+			state: report.OK,
+		},
+		{
+			name: "other schedule",
+			classes: []*storage.ReplicationClass{
+				testReplicationClass("vrc-1m", provisioner, peerClass.ReplicationID, "1m"),
+			},
+			state: report.Problem,
+		},
+		{
+			name: "other replication id",
+			classes: []*storage.ReplicationClass{
+				testReplicationClass("vrc-5m", provisioner, "other", "5m"),
+			},
+			state: report.Problem,
+		},
+		{
+			name: "other provisioner",
+			classes: []*storage.ReplicationClass{
+				testReplicationClass("vrc-5m", "other", peerClass.ReplicationID, "5m"),
+			},
+			state: report.Problem,
+		},
+		{
+			name:  "no classes",
+			state: report.Problem,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			validated := cmd.validatedPeerClassReplicationClass(
+				tt.classes, storageClass, peerClass, "5m")
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+			if validated.Value != tt.value {
+				t.Errorf("expected value %q, got %q", tt.value, validated.Value)
+			}
+		})
+	}
+}
+
+func TestValidatedManagedClusterLease(t *testing.T) {
+	now := stdtime.Date(2026, 2, 1, 0, 0, 0, 0, stdtime.UTC)
+	tests := []struct {
+		name    string
+		elapsed stdtime.Duration
+		state   report.ValidationState
+	}{
+		{name: "renewed", elapsed: 20 * stdtime.Second, state: report.OK},
+		{name: "late", elapsed: 3 * stdtime.Minute, state: report.Warning},
+		{name: "expired", elapsed: 5 * stdtime.Minute, state: report.Problem},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+			renewTime := metav1.NewMicroTime(now.Add(-tt.elapsed))
+			lease := &coordinationv1.Lease{
+				Spec: coordinationv1.LeaseSpec{RenewTime: &renewTime},
+			}
+			validated := cmd.validatedManagedClusterLease(lease, 60, now)
+			if validated.State != tt.state {
+				t.Errorf("expected state %q, got %q (%s)",
+					tt.state, validated.State, validated.Description)
+			}
+			if validated.Value == nil || !validated.Value.Equal(renewTime.Time) {
+				t.Errorf("expected value %v, got %v", renewTime.Time, validated.Value)
+			}
+		})
+	}
+	t.Run("missing lease", func(t *testing.T) {
+		cmd := testCommand(t, &helpers.ValidationMock{}, testK8s)
+		validated := cmd.validatedManagedClusterLease(nil, 60, now)
+		if validated.State != report.Problem {
+			t.Errorf("expected state %q, got %q", report.Problem, validated.State)
+		}
+	})
+}
+
+func testStorageClass(storageID string) *storagev1.StorageClass {
+	storageClass := &storagev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "rook-ceph-block"},
+		Provisioner: "rook-ceph.rbd.csi.ceph.com",
+	}
+	if storageID != "" {
+		storageClass.Labels = map[string]string{storage.StorageIDLabel: storageID}
+	}
+	return storageClass
+}
+
+func testReplicationClass(
+	name, provisioner, replicationID, schedulingInterval string,
+) *storage.ReplicationClass {
+	return &storage.ReplicationClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{storage.ReplicationIDLabel: replicationID},
+		},
+		Spec: storage.ReplicationClassSpec{
+			Provisioner: provisioner,
+			Parameters:  map[string]string{storage.SchedulingIntervalParameter: schedulingInterval},
+		},
+	}
+}
+
 func testCorruptedConfigMap() *corev1.ConfigMap {
 	return &corev1.ConfigMap{
 		Data: map[string]string{
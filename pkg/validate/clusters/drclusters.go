@@ -0,0 +1,314 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"fmt"
+	"slices"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/e2e/types"
+
+	"github.com/ramendr/ramenctl/pkg/ramen"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/storage"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// hubS3ProfileNames returns the names of the S3 profiles in the hub ramen config, or nil if the
+// hub ramen config was not gathered or is invalid.
+func (c *Command) hubS3ProfileNames() ([]string, error) {
+	config, err := c.readRamenConfig(c.Env().Hub, ramenapi.DRHubType)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, nil
+	}
+	names := []string{}
+	for i := range config.S3StoreProfiles {
+		names = append(names, config.S3StoreProfiles[i].S3ProfileName)
+	}
+	return names, nil
+}
+
+// drClusterPeers returns the peers of every DRCluster in the DRPolicies.
+func (c *Command) drClusterPeers() (map[string][]string, error) {
+	reader := c.OutputReader(c.Env().Hub.Name)
+
+	names, err := ramen.ListDRPolicies(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drpolicies: %w", err)
+	}
+
+	peers := map[string][]string{}
+	for _, name := range names {
+		drPolicy, err := ramen.ReadDRPolicy(reader, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read drpolicy %q: %w", name, err)
+		}
+		for _, cluster := range drPolicy.Spec.DRClusters {
+			for _, peer := range drPolicy.Spec.DRClusters {
+				if peer != cluster && !slices.Contains(peers[cluster], peer) {
+					peers[cluster] = append(peers[cluster], peer)
+				}
+			}
+		}
+	}
+
+	return peers, nil
+}
+
+// metroDRClusters returns the names of the DRClusters in Metro DR policies.
+func (c *Command) metroDRClusters() ([]string, error) {
+	reader := c.OutputReader(c.Env().Hub.Name)
+
+	names, err := ramen.ListDRPolicies(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drpolicies: %w", err)
+	}
+
+	var clusters []string
+	for _, name := range names {
+		drPolicy, err := ramen.ReadDRPolicy(reader, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read drpolicy %q: %w", name, err)
+		}
+		metro, err := ramen.IsMetroDRPolicy(reader, drPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect drpolicy %q: %w", name, err)
+		}
+		if !metro {
+			continue
+		}
+		for _, cluster := range drPolicy.Spec.DRClusters {
+			if !slices.Contains(clusters, cluster) {
+				clusters = append(clusters, cluster)
+			}
+		}
+	}
+
+	return clusters, nil
+}
+
+// validatedDRClusterS3Profile validates that the DRCluster S3 profile exists in the hub ramen
+// config. Returns nil if the hub S3 profiles are unknown.
+func (c *Command) validatedDRClusterS3Profile(
+	drCluster *ramenapi.DRCluster,
+	hubProfiles []string,
+) *report.ValidatedString {
+	if hubProfiles == nil {
+		return nil
+	}
+
+	validated := &report.ValidatedString{Value: drCluster.Spec.S3ProfileName}
+	if slices.Contains(hubProfiles, drCluster.Spec.S3ProfileName) {
+		validated.State = report.OK
+	} else {
+		validated.State = report.Problem
+		validated.Description = profileNotFoundInHub
+	}
+
+	summary.AddValidation(c.Report.Summary, validated)
+	return validated
+}
+
+// drClusterPeer is a peer DRCluster and its validated summary.
+type drClusterPeer struct {
+	drCluster *ramenapi.DRCluster
+	summary   *report.DRClusterSummary
+}
+
+// validatedClusterFence validates the DRCluster fencing state. A fenced cluster cannot run
+// applications, so fencing is reported as a warning. Fencing a cluster when a peer cluster is
+// fenced or in maintenance mode leaves no cluster to fail over to, and is reported as a problem.
+func (c *Command) validatedClusterFence(
+	drCluster *ramenapi.DRCluster,
+	peers []drClusterPeer,
+) *report.ValidatedString {
+	validated := &report.ValidatedString{Value: string(drCluster.Spec.ClusterFence)}
+
+	if !isFenced(drCluster.Spec.ClusterFence) {
+		validated.State = report.OK
+		summary.AddValidation(c.Report.Summary, validated)
+		return validated
+	}
+
+	validated.State = report.Warning
+	validated.Description = "Cluster is fenced"
+
+	for _, peer := range peers {
+		if isFenced(peer.drCluster.Spec.ClusterFence) {
+			validated.State = report.Problem
+			validated.Description = fmt.Sprintf("Cluster and peer cluster %q are fenced",
+				peer.drCluster.Name)
+			break
+		}
+		if len(peer.summary.MaintenanceModes) > 0 {
+			validated.State = report.Problem
+			validated.Description = fmt.Sprintf(
+				"Cluster is fenced and peer cluster %q is in maintenance mode",
+				peer.drCluster.Name)
+			break
+		}
+	}
+
+	summary.AddValidation(c.Report.Summary, validated)
+	return validated
+}
+
+// validatedFencingReady validates that a DRCluster in a Metro DR policy can be fenced. Ramen fences
+// a Metro DR cluster by fencing the cluster CIDRs on the peer cluster storage, so a cluster without
+// CIDRs cannot be fenced before failover. Returns nil for DRClusters not in a Metro DR policy.
+func (c *Command) validatedFencingReady(
+	drCluster *ramenapi.DRCluster,
+	metroClusters []string,
+) *report.ValidatedBool {
+	if !slices.Contains(metroClusters, drCluster.Name) {
+		return nil
+	}
+
+	validated := &report.ValidatedBool{Value: len(drCluster.Spec.CIDRs) > 0}
+	if validated.Value {
+		validated.State = report.OK
+	} else {
+		validated.State = report.Problem
+		validated.Description = "No CIDRs configured, cluster cannot be fenced"
+	}
+
+	summary.AddValidation(c.Report.Summary, validated)
+	return validated
+}
+
+func isFenced(state ramenapi.ClusterFenceState) bool {
+	return state == ramenapi.ClusterFenceStateFenced ||
+		state == ramenapi.ClusterFenceStateManuallyFenced
+}
+
+// validatedNetworkFences validates the NetworkFences created by ramen on the peer clusters to
+// fence the DRCluster. Managed clusters without gathered network fences are not validated.
+func (c *Command) validatedNetworkFences(
+	drCluster *ramenapi.DRCluster,
+) ([]report.NetworkFenceSummary, error) {
+	name := ramen.NetworkFenceName(drCluster.Name)
+
+	expectedState := storage.FenceStateUnfenced
+	if isFenced(drCluster.Spec.ClusterFence) {
+		expectedState = storage.FenceStateFenced
+	}
+
+	var fences []report.NetworkFenceSummary
+	for _, cluster := range c.ManagedClusters() {
+		if cluster.Name == drCluster.Name {
+			continue
+		}
+
+		reader := c.OutputReader(cluster.Name)
+		names, err := storage.ListNetworkFences(reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list network fences from cluster %q: %w",
+				cluster.Name, err)
+		}
+		if !slices.Contains(names, name) {
+			continue
+		}
+
+		nf, err := storage.ReadNetworkFence(reader, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read network fence %q from cluster %q: %w",
+				name, cluster.Name, err)
+		}
+
+		c.Logger().Debugf("Read network fence %q from cluster %q", name, cluster.Name)
+		fences = append(fences, report.NetworkFenceSummary{
+			Cluster:    cluster.Name,
+			Name:       name,
+			FenceState: c.validatedFenceState(nf.Spec.FenceState, expectedState),
+			Result:     c.validatedNetworkFenceResult(nf),
+		})
+	}
+
+	return fences, nil
+}
+
+func (c *Command) validatedFenceState(state, expectedState string) report.ValidatedString {
+	validated := report.ValidatedString{Value: state}
+	if state != expectedState {
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("Expected %q", expectedState)
+	} else {
+		validated.State = report.OK
+	}
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+func (c *Command) validatedNetworkFenceResult(nf *storage.NetworkFence) report.ValidatedString {
+	validated := report.ValidatedString{Value: nf.Status.Result}
+	if nf.Status.Result != storage.NetworkFenceResultSucceeded {
+		validated.State = report.Problem
+		validated.Description = "Fencing operation did not succeed"
+		if nf.Status.Message != "" {
+			validated.Description += ": " + nf.Status.Message
+		}
+	} else {
+		validated.State = report.OK
+	}
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// validatedMaintenanceModes validates the MaintenanceModes on the DRCluster managed cluster. Ramen
+// activates maintenance mode on the storage during failover, so an active maintenance mode is
+// reported as a warning, and a failed maintenance mode as a problem. Returns nil if the managed
+// cluster is not in the environment or maintenance modes were not gathered.
+func (c *Command) validatedMaintenanceModes(
+	drCluster *ramenapi.DRCluster,
+) ([]report.MaintenanceModeSummary, error) {
+	idx := slices.IndexFunc(c.ManagedClusters(), func(cluster *types.Cluster) bool {
+		return cluster.Name == drCluster.Name
+	})
+	if idx == -1 {
+		c.Logger().Debugf("DRCluster %q is not a managed cluster in the environment",
+			drCluster.Name)
+		return nil, nil
+	}
+	cluster := c.ManagedClusters()[idx]
+	reader := c.OutputReader(cluster.Name)
+
+	names, err := ramen.ListMaintenanceModes(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance modes from cluster %q: %w",
+			cluster.Name, err)
+	}
+
+	var modes []report.MaintenanceModeSummary
+	for _, name := range names {
+		mm, err := ramen.ReadMaintenanceMode(reader, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read maintenance mode %q from cluster %q: %w",
+				name, cluster.Name, err)
+		}
+
+		c.Logger().Debugf("Read maintenance mode %q from cluster %q", name, cluster.Name)
+		state := report.ValidatedString{Value: string(mm.Status.State)}
+		if mm.Status.State == ramenapi.MModeStateError {
+			state.State = report.Problem
+			state.Description = "Maintenance mode failed"
+		} else {
+			state.State = report.Warning
+			state.Description = "Maintenance mode is active"
+		}
+		summary.AddValidation(c.Report.Summary, &state)
+
+		modes = append(modes, report.MaintenanceModeSummary{
+			Name:               name,
+			StorageProvisioner: mm.Spec.StorageProvisioner,
+			TargetID:           mm.Spec.TargetID,
+			State:              state,
+		})
+	}
+
+	return modes, nil
+}
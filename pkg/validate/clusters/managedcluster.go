@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	stdtime "time"
+
+	"github.com/ramendr/ramen/e2e/types"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ocmaddonv1a1 "open-cluster-management.io/api/addon/v1alpha1"
+	ocmv1 "open-cluster-management.io/api/cluster/v1"
+
+	"github.com/ramendr/ramenctl/pkg/ocm"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/time"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// Conditions that must be True for a healthy managed cluster.
+var managedClusterConditions = []string{
+	ocmv1.ManagedClusterConditionHubAccepted,
+	ocmv1.ManagedClusterConditionJoined,
+	ocmv1.ManagedClusterConditionAvailable,
+}
+
+// validatedManagedCluster validates the OCM ManagedCluster, its lease, and the ramen
+// ManagedClusterAddOns on the hub. Returns nil if the hub managed clusters were not gathered.
+func (c *Command) validatedManagedCluster(
+	cluster *types.Cluster,
+) (*report.ManagedClusterSummary, error) {
+	reader := c.OutputReader(c.Env().Hub.Name)
+
+	names, err := ocm.ListManagedClusters(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list managed clusters: %w", err)
+	}
+	if len(names) == 0 {
+		c.Logger().Debugf("No managed clusters gathered from the hub")
+		return nil, nil
+	}
+
+	s := &report.ManagedClusterSummary{}
+
+	managedCluster, err := ocm.ReadManagedCluster(reader, cluster.Name)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read managed cluster %q: %w", cluster.Name, err)
+		}
+		s.Deleted = c.ValidatedDeleted(nil)
+		return s, nil
+	}
+
+	s.Deleted = c.ValidatedDeleted(managedCluster)
+
+	for _, conditionType := range managedClusterConditions {
+		validated := c.ValidatedRequiredCondition(
+			managedCluster.Status.Conditions,
+			conditionType,
+			metav1.ConditionTrue,
+		)
+		s.Conditions = append(s.Conditions, validated)
+	}
+
+	lease, err := ocm.ReadManagedClusterLease(reader, cluster.Name)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read managed cluster %q lease: %w", cluster.Name, err)
+		}
+		lease = nil
+	}
+
+	s.Lease = c.validatedManagedClusterLease(lease, ocm.LeaseDuration(managedCluster), time.Now())
+
+	for _, name := range ocm.RamenAddOns {
+		addon, err := ocm.ReadManagedClusterAddOn(reader, name, cluster.Name)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read managed cluster %q addon %q: %w",
+				cluster.Name, name, err)
+		}
+		s.AddOns = append(s.AddOns, report.ManagedClusterAddOnSummary{
+			Name:       addon.Name,
+			Conditions: c.validatedManagedClusterAddOnConditions(addon),
+		})
+	}
+
+	return s, nil
+}
+
+func (c *Command) validatedManagedClusterAddOnConditions(
+	addon *ocmaddonv1a1.ManagedClusterAddOn,
+) []report.ValidatedCondition {
+	conditions := []report.ValidatedCondition{
+		c.ValidatedRequiredCondition(
+			addon.Status.Conditions,
+			ocmaddonv1a1.ManagedClusterAddOnConditionAvailable,
+			metav1.ConditionTrue,
+		),
+	}
+
+	// The Degraded condition is reported only by some addons.
+	degraded := ocmaddonv1a1.ManagedClusterAddOnConditionDegraded
+	if meta.FindStatusCondition(addon.Status.Conditions, degraded) != nil {
+		conditions = append(conditions, c.ValidatedRequiredCondition(
+			addon.Status.Conditions,
+			degraded,
+			metav1.ConditionFalse,
+		))
+	}
+
+	return conditions
+}
+
+// validatedManagedClusterLease validates that the klusterlet agent renewed the lease recently. The
+// hub marks the cluster as unavailable if the lease was not renewed during the grace period.
+func (c *Command) validatedManagedClusterLease(
+	lease *coordinationv1.Lease,
+	leaseDurationSeconds int32,
+	now time.Time,
+) report.ValidatedTime {
+	validated := report.ValidatedTime{}
+
+	if lease == nil || lease.Spec.RenewTime == nil {
+		validated.State = report.Problem
+		validated.Description = "Lease was never renewed"
+		summary.AddValidation(c.Report.Summary, &validated)
+		return validated
+	}
+
+	// Convert to UTC for consistency with other timestamps in YAML and HTML reports.
+	renewTime := lease.Spec.RenewTime.UTC()
+	validated.Value = &renewTime
+
+	leaseDuration := stdtime.Duration(leaseDurationSeconds) * stdtime.Second
+	elapsed := now.Sub(renewTime)
+
+	switch {
+	case elapsed >= ocm.LeaseGracePeriod*leaseDuration:
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("Lease was not renewed in %s",
+			elapsed.Round(stdtime.Second))
+	case elapsed > 2*leaseDuration:
+		validated.State = report.Warning
+		validated.Description = fmt.Sprintf("Lease was not renewed in %s",
+			elapsed.Round(stdtime.Second))
+	default:
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
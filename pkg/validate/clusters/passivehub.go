@@ -0,0 +1,319 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	stdtime "time"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/e2e/types"
+
+	"github.com/ramendr/ramenctl/pkg/ocm"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// validatePassiveHub validates the passive hub used to recover the hub. The hub backup is validated
+// on the active hub, and the passive hub must restore the hub backups, run the same ramen version,
+// and use the same S3 profiles as the hub. Returns nil if the environment has no passive hub.
+func (c *Command) validatePassiveHub() (*report.ClustersStatusPassiveHub, error) {
+	env := c.Env()
+	if env.PassiveHub == nil {
+		return nil, nil
+	}
+
+	s := &report.ClustersStatusPassiveHub{Name: env.PassiveHub.Name}
+
+	if err := c.validateBackupSchedule(&s.BackupSchedule); err != nil {
+		return nil, fmt.Errorf("failed to validate backup schedule: %w", err)
+	}
+
+	if err := c.validateRestore(&s.Restore, env.PassiveHub); err != nil {
+		return nil, fmt.Errorf("failed to validate restore: %w", err)
+	}
+
+	if err := c.validateRamen(&s.Ramen, env.PassiveHub, ramenapi.DRHubType); err != nil {
+		return nil, fmt.Errorf("failed to validate ramen: %w", err)
+	}
+
+	version, err := c.validatedPassiveHubOperatorVersion(env.Hub, env.PassiveHub)
+	if err != nil {
+		return nil, err
+	}
+	s.OperatorVersion = version
+
+	s3Profiles, err := c.validatedPassiveHubS3Profiles(env.Hub, env.PassiveHub)
+	if err != nil {
+		return nil, err
+	}
+	s.S3Profiles = s3Profiles
+
+	return s, nil
+}
+
+// validateBackupSchedule validates the hub backup schedule and the last successful hub backup on
+// the active hub.
+func (c *Command) validateBackupSchedule(s *report.BackupScheduleSummary) error {
+	hub := c.Env().Hub
+	reader := c.OutputReader(hub.Name)
+	namespace := c.Config().Namespaces.OCMBackupNamespace
+
+	names, err := ocm.ListBackupSchedules(reader, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list backup schedules from cluster %q: %w", hub.Name, err)
+	}
+
+	var schedule *ocm.BackupSchedule
+	if len(names) > 0 {
+		// The hub supports a single backup schedule.
+		schedule, err = ocm.ReadBackupSchedule(reader, namespace, names[0])
+		if err != nil {
+			return fmt.Errorf("failed to read backup schedule %q from cluster %q: %w",
+				names[0], hub.Name, err)
+		}
+		c.Logger().Debugf("Read backup schedule %q from cluster %q", schedule.Name, hub.Name)
+		s.Name = schedule.Name
+	}
+	s.Phase = c.validatedBackupSchedulePhase(schedule)
+
+	lastBackupTime, err := ocm.LastBackupTime(reader, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to read backups from cluster %q: %w", hub.Name, err)
+	}
+	hubTime, err := c.clusterTime(hub, ramenapi.DRHubType)
+	if err != nil {
+		return fmt.Errorf("failed to read cluster %q time: %w", hub.Name, err)
+	}
+	s.LastBackupTime = c.validatedLastBackupTime(lastBackupTime, hubTime)
+
+	return nil
+}
+
+func (c *Command) validatedBackupSchedulePhase(
+	schedule *ocm.BackupSchedule,
+) report.ValidatedString {
+	var validated report.ValidatedString
+
+	switch {
+	case schedule == nil:
+		validated.State = report.Problem
+		validated.Description = "Backup schedule not found"
+	case schedule.Status.Phase != ocm.BackupSchedulePhaseEnabled:
+		validated.Value = schedule.Status.Phase
+		validated.State = report.Problem
+		validated.Description = "Backup schedule is not enabled"
+		if schedule.Status.LastMessage != "" {
+			validated.Description += ": " + schedule.Status.LastMessage
+		}
+	default:
+		validated.Value = schedule.Status.Phase
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// validatedLastBackupTime validates that the last successful backup is not older than the backup
+// age threshold at the hub time. If the hub time is unknown the backup age is not validated.
+func (c *Command) validatedLastBackupTime(
+	lastBackupTime *stdtime.Time,
+	hubTime *stdtime.Time,
+) report.ValidatedTime {
+	validated := report.ValidatedTime{}
+	if lastBackupTime == nil {
+		validated.State = report.Problem
+		validated.Description = "No successful backup found"
+		summary.AddValidation(c.Report.Summary, &validated)
+		return validated
+	}
+
+	// Backup times are parsed in the local time zone. Report them in UTC like other times.
+	utc := lastBackupTime.UTC()
+	validated.Value = &utc
+
+	threshold := c.Config().Validation.BackupAge()
+	if hubTime != nil && hubTime.Sub(utc) > threshold {
+		validated.State = report.Warning
+		validated.Description = fmt.Sprintf("Last backup is older than %s", threshold)
+	} else {
+		if hubTime == nil {
+			c.Logger().Debugf("Cannot validate last backup age: hub time is unknown")
+		}
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// validateRestore validates that the passive hub restores the hub backups. The passive hub should
+// sync new backups continuously to be ready for hub recovery.
+func (c *Command) validateRestore(s *report.RestoreSummary, passiveHub *types.Cluster) error {
+	reader := c.OutputReader(passiveHub.Name)
+	namespace := c.Config().Namespaces.OCMBackupNamespace
+
+	names, err := ocm.ListRestores(reader, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list restores from cluster %q: %w", passiveHub.Name, err)
+	}
+
+	var restore *ocm.Restore
+	for _, name := range names {
+		r, err := ocm.ReadRestore(reader, namespace, name)
+		if err != nil {
+			return fmt.Errorf("failed to read restore %q from cluster %q: %w",
+				name, passiveHub.Name, err)
+		}
+		c.Logger().Debugf("Read restore %q from cluster %q", name, passiveHub.Name)
+		// Prefer the restore syncing new backups.
+		if restore == nil || r.Spec.SyncRestoreWithNewBackups {
+			restore = r
+		}
+	}
+
+	if restore != nil {
+		s.Name = restore.Name
+		s.Sync = restore.Spec.SyncRestoreWithNewBackups
+	}
+	s.Phase = c.validatedRestorePhase(restore)
+
+	return nil
+}
+
+func (c *Command) validatedRestorePhase(restore *ocm.Restore) report.ValidatedString {
+	var validated report.ValidatedString
+
+	switch {
+	case restore == nil:
+		validated.State = report.Problem
+		validated.Description = "Restore not found"
+	case restore.Status.Phase == ocm.RestorePhaseEnabled:
+		validated.Value = restore.Status.Phase
+		validated.State = report.OK
+	case restore.Status.Phase == ocm.RestorePhaseFinished &&
+		!restore.Spec.SyncRestoreWithNewBackups:
+		validated.Value = restore.Status.Phase
+		validated.State = report.Warning
+		validated.Description = "Restore is not syncing new backups"
+	default:
+		validated.Value = restore.Status.Phase
+		validated.State = report.Problem
+		validated.Description = "Restore is not ready"
+		if restore.Status.LastMessage != "" {
+			validated.Description += ": " + restore.Status.LastMessage
+		}
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// validatedPassiveHubOperatorVersion validates that the passive hub ramen operator version matches
+// the hub version. Returns an empty value if the passive hub operator deployment was not found.
+func (c *Command) validatedPassiveHubOperatorVersion(
+	hub, passiveHub *types.Cluster,
+) (report.ValidatedString, error) {
+	operator, err := c.operatorVersion(passiveHub, ramenapi.DRHubType)
+	if err != nil {
+		return report.ValidatedString{}, err
+	}
+	if operator == nil {
+		return report.ValidatedString{}, nil
+	}
+
+	hubOperator, err := c.operatorVersion(hub, ramenapi.DRHubType)
+	if err != nil {
+		return report.ValidatedString{}, err
+	}
+	var hubVersion string
+	if hubOperator != nil {
+		hubVersion = hubOperator.Version.Value
+	}
+
+	return c.validatedOperatorVersion(operator.Version.Value, hubVersion), nil
+}
+
+// validatedPassiveHubS3Profiles validates that the passive hub ramen config has the same S3
+// profiles as the hub. Returns an empty value if the hub or passive hub config is missing or
+// invalid.
+func (c *Command) validatedPassiveHubS3Profiles(
+	hub, passiveHub *types.Cluster,
+) (report.ValidatedBool, error) {
+	hubConfig, err := c.readRamenConfig(hub, ramenapi.DRHubType)
+	if err != nil {
+		return report.ValidatedBool{}, err
+	}
+	passiveHubConfig, err := c.readRamenConfig(passiveHub, ramenapi.DRHubType)
+	if err != nil {
+		return report.ValidatedBool{}, err
+	}
+	if hubConfig == nil || passiveHubConfig == nil {
+		return report.ValidatedBool{}, nil
+	}
+
+	return c.validatedSameS3Profiles(hubConfig.S3StoreProfiles, passiveHubConfig.S3StoreProfiles),
+		nil
+}
+
+func (c *Command) validatedSameS3Profiles(
+	hubProfiles, profiles []ramenapi.S3StoreProfile,
+) report.ValidatedBool {
+	var missing, different, unexpected []string
+
+	for i := range hubProfiles {
+		hubProfile := &hubProfiles[i]
+		idx := slices.IndexFunc(profiles, func(p ramenapi.S3StoreProfile) bool {
+			return p.S3ProfileName == hubProfile.S3ProfileName
+		})
+		if idx == -1 {
+			missing = append(missing, hubProfile.S3ProfileName)
+		} else if !sameS3Profile(hubProfile, &profiles[idx]) {
+			different = append(different, hubProfile.S3ProfileName)
+		}
+	}
+
+	for i := range profiles {
+		profile := &profiles[i]
+		if !slices.ContainsFunc(hubProfiles, func(p ramenapi.S3StoreProfile) bool {
+			return p.S3ProfileName == profile.S3ProfileName
+		}) {
+			unexpected = append(unexpected, profile.S3ProfileName)
+		}
+	}
+
+	var issues []string
+	if len(missing) > 0 {
+		issues = append(issues, fmt.Sprintf("missing %q", missing))
+	}
+	if len(different) > 0 {
+		issues = append(issues, fmt.Sprintf("different %q", different))
+	}
+	if len(unexpected) > 0 {
+		issues = append(issues, fmt.Sprintf("not in hub %q", unexpected))
+	}
+
+	validated := report.ValidatedBool{Value: len(issues) == 0}
+	if validated.Value {
+		validated.State = report.OK
+	} else {
+		validated.State = report.Problem
+		validated.Description = "S3 profiles differ from hub: " + strings.Join(issues, ", ")
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+func sameS3Profile(a, b *ramenapi.S3StoreProfile) bool {
+	return a.S3Bucket == b.S3Bucket &&
+		a.S3CompatibleEndpoint == b.S3CompatibleEndpoint &&
+		a.S3Region == b.S3Region &&
+		a.S3SecretRef == b.S3SecretRef &&
+		bytes.Equal(a.CACertificates, b.CACertificates)
+}
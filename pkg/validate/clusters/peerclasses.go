@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/e2e/types"
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/storage"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// validatedPeerClassClusters validates the storage class and replication class matching the peer
+// class on the managed clusters in the DRPolicy. DRPolicy clusters which are not configured are
+// reported as a problem, since we did not gather their storage classes. Clusters without gathered
+// storage classes are skipped.
+func (c *Command) validatedPeerClassClusters(
+	drPolicy *ramenapi.DRPolicy,
+	peerClass *ramenapi.PeerClass,
+) ([]report.PeerClassClusterSummary, error) {
+	var clusters []report.PeerClassClusterSummary
+
+	for _, name := range drPolicy.Spec.DRClusters {
+		idx := slices.IndexFunc(c.ManagedClusters(), func(cluster *types.Cluster) bool {
+			return cluster.Name == name
+		})
+		if idx == -1 {
+			pcs := report.PeerClassClusterSummary{Name: name}
+			pcs.StorageClass.State = report.Problem
+			pcs.StorageClass.Description = "Cluster not configured"
+			summary.AddValidation(c.Report.Summary, &pcs.StorageClass)
+			clusters = append(clusters, pcs)
+			continue
+		}
+
+		pcs, err := c.validatedPeerClassCluster(c.ManagedClusters()[idx], drPolicy, peerClass)
+		if err != nil {
+			return nil, err
+		}
+		if pcs != nil {
+			clusters = append(clusters, *pcs)
+		}
+	}
+
+	return clusters, nil
+}
+
+func (c *Command) validatedPeerClassCluster(
+	cluster *types.Cluster,
+	drPolicy *ramenapi.DRPolicy,
+	peerClass *ramenapi.PeerClass,
+) (*report.PeerClassClusterSummary, error) {
+	log := c.Logger()
+	reader := c.OutputReader(cluster.Name)
+
+	names, err := storage.ListStorageClasses(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storage classes from cluster %q: %w",
+			cluster.Name, err)
+	}
+	if len(names) == 0 {
+		log.Debugf("No storage classes gathered from cluster %q", cluster.Name)
+		return nil, nil
+	}
+
+	pcs := &report.PeerClassClusterSummary{Name: cluster.Name}
+
+	storageClass, err := storage.ReadStorageClass(reader, peerClass.StorageClassName)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read storage class %q from cluster %q: %w",
+				peerClass.StorageClassName, cluster.Name, err)
+		}
+		storageClass = nil
+	}
+
+	pcs.StorageClass = c.validatedPeerClassStorageClass(storageClass, peerClass)
+
+	if storageClass == nil || peerClass.ReplicationID == "" {
+		// Missing storage class reported above, and peer classes without a replication ID use
+		// volsync.
+		return pcs, nil
+	}
+
+	classes, err := storage.ReadReplicationClasses(reader, peerClass.Grouping)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read replication classes from cluster %q: %w",
+			cluster.Name, err)
+	}
+
+	validated := c.validatedPeerClassReplicationClass(
+		classes,
+		storageClass,
+		peerClass,
+		drPolicy.Spec.SchedulingInterval,
+	)
+	pcs.ReplicationClass = &validated
+
+	return pcs, nil
+}
+
+// validatedPeerClassStorageClass validates that the storage class exists and its storageID is one
+// of the peer class storageIDs. The value is the storage class storageID.
+func (c *Command) validatedPeerClassStorageClass(
+	storageClass *storagev1.StorageClass,
+	peerClass *ramenapi.PeerClass,
+) report.ValidatedString {
+	validated := report.ValidatedString{}
+
+	if storageClass == nil {
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("StorageClass %q not found",
+			peerClass.StorageClassName)
+		summary.AddValidation(c.Report.Summary, &validated)
+		return validated
+	}
+
+	validated.Value = storageClass.Labels[storage.StorageIDLabel]
+
+	switch {
+	case validated.Value == "":
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("StorageClass %q has no %q label",
+			storageClass.Name, storage.StorageIDLabel)
+	case !slices.Contains(peerClass.StorageID, validated.Value):
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("StorageID does not match peer class storageIDs %q",
+			peerClass.StorageID)
+	default:
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// validatedPeerClassReplicationClass validates that a replication class matching the storage
+// class provisioner and the peer class replicationID exists, with a schedule matching the DRPolicy
+// schedulingInterval. The value is the name of the matching replication class.
+func (c *Command) validatedPeerClassReplicationClass(
+	classes []*storage.ReplicationClass,
+	storageClass *storagev1.StorageClass,
+	peerClass *ramenapi.PeerClass,
+	schedulingInterval string,
+) report.ValidatedString {
+	validated := report.ValidatedString{}
+	kind := storage.ReplicationClassKind(peerClass.Grouping)
+
+	var candidates []*storage.ReplicationClass
+	for _, class := range classes {
+		if class.Spec.Provisioner == storageClass.Provisioner &&
+			class.Labels[storage.ReplicationIDLabel] == peerClass.ReplicationID {
+			candidates = append(candidates, class)
+		}
+	}
+
+	if len(candidates) == 0 {
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("No %s with provisioner %q and replicationID %q",
+			kind, storageClass.Provisioner, peerClass.ReplicationID)
+		summary.AddValidation(c.Report.Summary, &validated)
+		return validated
+	}
+
+	for _, class := range candidates {
+		if class.Spec.Parameters[storage.SchedulingIntervalParameter] == schedulingInterval {
+			validated.Value = class.Name
+			validated.State = report.OK
+			summary.AddValidation(c.Report.Summary, &validated)
+			return validated
+		}
+	}
+
+	validated.State = report.Problem
+	validated.Description = fmt.Sprintf("No %s with schedulingInterval %q", kind, schedulingInterval)
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
@@ -0,0 +1,270 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/e2e/types"
+
+	"github.com/ramendr/ramenctl/pkg/ramen"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// ramenConfigField is a ramen config field that must be consistent on the hub and managed
+// clusters.
+type ramenConfigField struct {
+	name  string
+	value func(*ramenapi.RamenConfig) string
+	// mismatch is the validation state when the managed cluster value differs from the hub.
+	mismatch report.ValidationState
+}
+
+// ramenConfigFields are the ramen config fields compared between the hub and managed clusters. The
+// hub propagates its config to the managed clusters, so the managed clusters config must be the
+// same except the controller type. The dr cluster operator fields are used only on the hub, so
+// different values on managed clusters are reported as a warning.
+var ramenConfigFields = []ramenConfigField{
+	{
+		name: "maxConcurrentReconciles",
+		value: func(c *ramenapi.RamenConfig) string {
+			return strconv.Itoa(c.MaxConcurrentReconciles)
+		},
+		mismatch: report.Problem,
+	},
+	{
+		name:     "ramenOpsNamespace",
+		value:    func(c *ramenapi.RamenConfig) string { return c.RamenOpsNamespace },
+		mismatch: report.Problem,
+	},
+	{
+		name: "volumeUnprotectionEnabled",
+		value: func(c *ramenapi.RamenConfig) string {
+			return strconv.FormatBool(c.VolumeUnprotectionEnabled)
+		},
+		mismatch: report.Problem,
+	},
+	{
+		name: "drClusterOperator.deploymentAutomationEnabled",
+		value: func(c *ramenapi.RamenConfig) string {
+			return strconv.FormatBool(c.DrClusterOperator.DeploymentAutomationEnabled)
+		},
+		mismatch: report.Warning,
+	},
+	{
+		name: "drClusterOperator.s3SecretDistributionEnabled",
+		value: func(c *ramenapi.RamenConfig) string {
+			return strconv.FormatBool(c.DrClusterOperator.S3SecretDistributionEnabled)
+		},
+		mismatch: report.Warning,
+	},
+	{
+		name:     "drClusterOperator.channelName",
+		value:    func(c *ramenapi.RamenConfig) string { return c.DrClusterOperator.ChannelName },
+		mismatch: report.Warning,
+	},
+	{
+		name:     "drClusterOperator.packageName",
+		value:    func(c *ramenapi.RamenConfig) string { return c.DrClusterOperator.PackageName },
+		mismatch: report.Warning,
+	},
+	{
+		name:     "drClusterOperator.namespaceName",
+		value:    func(c *ramenapi.RamenConfig) string { return c.DrClusterOperator.NamespaceName },
+		mismatch: report.Warning,
+	},
+	{
+		name: "drClusterOperator.catalogSourceName",
+		value: func(c *ramenapi.RamenConfig) string {
+			return c.DrClusterOperator.CatalogSourceName
+		},
+		mismatch: report.Warning,
+	},
+	{
+		name: "drClusterOperator.catalogSourceNamespaceName",
+		value: func(c *ramenapi.RamenConfig) string {
+			return c.DrClusterOperator.CatalogSourceNamespaceName
+		},
+		mismatch: report.Warning,
+	},
+	{
+		name: "drClusterOperator.clusterServiceVersionName",
+		value: func(c *ramenapi.RamenConfig) string {
+			return c.DrClusterOperator.ClusterServiceVersionName
+		},
+		mismatch: report.Warning,
+	},
+	{
+		name: "volSync.disabled",
+		value: func(c *ramenapi.RamenConfig) string {
+			return strconv.FormatBool(c.VolSync.Disabled)
+		},
+		mismatch: report.Problem,
+	},
+	{
+		name:     "volSync.destinationCopyMethod",
+		value:    func(c *ramenapi.RamenConfig) string { return c.VolSync.DestinationCopyMethod },
+		mismatch: report.Problem,
+	},
+	{
+		name: "kubeObjectProtection.disabled",
+		value: func(c *ramenapi.RamenConfig) string {
+			return strconv.FormatBool(c.KubeObjectProtection.Disabled)
+		},
+		mismatch: report.Problem,
+	},
+	{
+		name: "kubeObjectProtection.veleroNamespaceName",
+		value: func(c *ramenapi.RamenConfig) string {
+			return c.KubeObjectProtection.VeleroNamespaceName
+		},
+		mismatch: report.Problem,
+	},
+	{
+		name: "multiNamespace.FeatureEnabled",
+		value: func(c *ramenapi.RamenConfig) string {
+			return strconv.FormatBool(c.MultiNamespace.FeatureEnabled)
+		},
+		mismatch: report.Problem,
+	},
+	{
+		name: "multiNamespace.volsyncSupported",
+		value: func(c *ramenapi.RamenConfig) string {
+			return strconv.FormatBool(c.MultiNamespace.VolsyncSupported)
+		},
+		mismatch: report.Problem,
+	},
+}
+
+// validateRamenConfigs compares the ramen config fields on the hub and managed clusters, and
+// validates the hub fields depending on the environment. Clusters with a missing or invalid
+// configmap are not compared, since the configmap is validated when validating ramen. Returns nil
+// if the hub config is missing or invalid.
+func (c *Command) validateRamenConfigs() ([]report.RamenConfigFieldSummary, error) {
+	env := c.Env()
+
+	hubConfig, err := c.readRamenConfig(env.Hub, ramenapi.DRHubType)
+	if err != nil {
+		return nil, err
+	}
+	if hubConfig == nil {
+		return nil, nil
+	}
+
+	type clusterConfig struct {
+		name   string
+		config *ramenapi.RamenConfig
+	}
+
+	var clusterConfigs []clusterConfig
+	for _, cluster := range c.ManagedClusters() {
+		config, err := c.readRamenConfig(cluster, ramenapi.DRClusterType)
+		if err != nil {
+			return nil, err
+		}
+		if config != nil {
+			clusterConfigs = append(clusterConfigs, clusterConfig{cluster.Name, config})
+		}
+	}
+
+	var fields []report.RamenConfigFieldSummary
+	for i := range ramenConfigFields {
+		field := &ramenConfigFields[i]
+		hubValue := field.value(hubConfig)
+		fs := report.RamenConfigFieldSummary{
+			Name: field.name,
+			Hub:  c.validatedHubRamenConfigField(field.name, hubValue, hubConfig),
+		}
+		for _, cc := range clusterConfigs {
+			fs.Clusters = append(fs.Clusters, report.RamenConfigFieldValue{
+				Cluster: cc.name,
+				Value:   c.validatedRamenConfigField(field, field.value(cc.config), hubValue),
+			})
+		}
+		fields = append(fields, fs)
+	}
+
+	return fields, nil
+}
+
+// readRamenConfig reads and parses the ramen config from the cluster ramen configmap. Returns nil
+// if the configmap was not gathered or cannot be parsed.
+func (c *Command) readRamenConfig(
+	cluster *types.Cluster,
+	controllerType ramenapi.ControllerType,
+) (*ramenapi.RamenConfig, error) {
+	operator := ramen.Operator(c.Config(), cluster.Name, controllerType)
+	configMap, err := c.readRamenConfigMap(cluster, operator.ConfigMap, operator.Namespace)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read configmap: %w", err)
+	}
+
+	config, err := ramen.ParseRamenConfig(configMap)
+	if err != nil {
+		c.Logger().Debugf("Cannot compare ramen config from cluster %q: %s", cluster.Name, err)
+		return nil, nil
+	}
+
+	return config, nil
+}
+
+// validatedHubRamenConfigField validates hub ramen config fields that must match the environment.
+// Other fields are not validated.
+func (c *Command) validatedHubRamenConfigField(
+	name, value string,
+	config *ramenapi.RamenConfig,
+) report.ValidatedString {
+	validated := report.ValidatedString{Value: value}
+
+	var expected string
+	switch name {
+	case "ramenOpsNamespace":
+		expected = c.Config().Namespaces.RamenOpsNamespace
+	case "drClusterOperator.namespaceName":
+		// Used only when the hub deploys the dr cluster operator.
+		if !config.DrClusterOperator.DeploymentAutomationEnabled {
+			return validated
+		}
+		expected = c.Config().Namespaces.RamenDRClusterNamespace
+	default:
+		return validated
+	}
+
+	if value != expected {
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("Expected %q", expected)
+	} else {
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// validatedRamenConfigField validates that a managed cluster ramen config field value is the same
+// as the hub value.
+func (c *Command) validatedRamenConfigField(
+	field *ramenConfigField,
+	value, hubValue string,
+) report.ValidatedString {
+	validated := report.ValidatedString{Value: value}
+
+	if value != hubValue {
+		validated.State = field.mismatch
+		validated.Description = fmt.Sprintf("Differs from hub value %q", hubValue)
+	} else {
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package clusters
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/e2e/types"
+
+	"github.com/ramendr/ramenctl/pkg/ramen"
+	"github.com/ramendr/ramenctl/pkg/report"
+	"github.com/ramendr/ramenctl/pkg/validate/summary"
+)
+
+// validateVersions validates that the ramen operators on all clusters use the same version, and
+// that the ramen CRDs serve the API version ramenctl was built with.
+func (c *Command) validateVersions() (*report.ClustersVersionsStatus, error) {
+	s := &report.ClustersVersionsStatus{APIVersion: ramenapi.GroupVersion.String()}
+
+	type clusterType struct {
+		cluster        *types.Cluster
+		controllerType ramenapi.ControllerType
+	}
+
+	env := c.Env()
+	clusters := []clusterType{{env.Hub, ramenapi.DRHubType}}
+	for _, cluster := range c.ManagedClusters() {
+		clusters = append(clusters, clusterType{cluster, ramenapi.DRClusterType})
+	}
+
+	var hubVersion string
+	for _, ct := range clusters {
+		operator, err := c.operatorVersion(ct.cluster, ct.controllerType)
+		if err != nil {
+			return nil, err
+		}
+		if operator != nil {
+			if ct.controllerType == ramenapi.DRHubType {
+				hubVersion = operator.Version.Value
+			}
+			operator.Version = c.validatedOperatorVersion(operator.Version.Value, hubVersion)
+			s.Operators = append(s.Operators, *operator)
+		}
+
+		crds, err := c.validatedCRDs(ct.cluster, ct.controllerType)
+		if err != nil {
+			return nil, err
+		}
+		s.CRDs = append(s.CRDs, crds...)
+	}
+
+	return s, nil
+}
+
+// operatorVersion returns the operator version summary for the cluster, or nil if the operator
+// deployment was not found. Missing deployment is reported when validating ramen.
+func (c *Command) operatorVersion(
+	cluster *types.Cluster,
+	controllerType ramenapi.ControllerType,
+) (*report.OperatorVersionSummary, error) {
+	operator := ramen.Operator(c.Config(), cluster.Name, controllerType)
+	deployment, err := c.readRamenDeployment(cluster, operator.Deployment, operator.Namespace)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read deployment: %w", err)
+	}
+	image, version := ramen.OperatorVersion(deployment)
+	return &report.OperatorVersionSummary{
+		Cluster: cluster.Name,
+		Image:   image,
+		Version: report.ValidatedString{Value: version},
+	}, nil
+}
+
+func (c *Command) validatedOperatorVersion(version, hubVersion string) report.ValidatedString {
+	validated := report.ValidatedString{Value: version}
+
+	switch {
+	case version == "":
+		validated.State = report.Warning
+		validated.Description = "Unable to determine operator version"
+	case hubVersion != "" && version != hubVersion:
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("Version does not match hub version %q", hubVersion)
+	default:
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// validatedCRDs validates the ramen CRDs used by ramenctl on the cluster. Returns nil if the
+// cluster CRDs were not gathered.
+func (c *Command) validatedCRDs(
+	cluster *types.Cluster,
+	controllerType ramenapi.ControllerType,
+) ([]report.CRDSummary, error) {
+	reader := c.OutputReader(cluster.Name)
+
+	names, err := ramen.ListCRDs(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list crds from cluster %q: %w", cluster.Name, err)
+	}
+	if len(names) == 0 {
+		c.Logger().Debugf("No crds gathered from cluster %q", cluster.Name)
+		return nil, nil
+	}
+
+	var crds []report.CRDSummary
+	for _, name := range ramen.CRDNames(controllerType) {
+		crd := report.CRDSummary{Cluster: cluster.Name, Name: name}
+		if !slices.Contains(names, name) {
+			crd.StorageVersion = report.ValidatedString{
+				Validated: report.Validated{
+					State:       report.Problem,
+					Description: "CRD is not installed",
+				},
+			}
+			summary.AddValidation(c.Report.Summary, &crd.StorageVersion)
+			crds = append(crds, crd)
+			continue
+		}
+
+		versions, err := ramen.ReadCRDVersions(reader, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read crd %q from cluster %q: %w",
+				name, cluster.Name, err)
+		}
+		var storageVersion string
+		var apiVersion *ramen.CRDVersion
+		for i := range versions {
+			v := &versions[i]
+			if v.Served {
+				crd.ServedVersions = append(crd.ServedVersions, v.Name)
+			}
+			if v.Storage {
+				storageVersion = v.Name
+			}
+			if v.Name == ramenapi.GroupVersion.Version {
+				apiVersion = v
+			}
+		}
+		crd.StorageVersion = c.validatedCRDStorageVersion(storageVersion, crd.ServedVersions)
+		if apiVersion != nil {
+			crd.Schema = c.validatedCRDSchema(name, apiVersion)
+		}
+		crds = append(crds, crd)
+	}
+
+	return crds, nil
+}
+
+func (c *Command) validatedCRDStorageVersion(
+	storageVersion string,
+	servedVersions []string,
+) report.ValidatedString {
+	validated := report.ValidatedString{Value: storageVersion}
+	apiVersion := ramenapi.GroupVersion.Version
+
+	switch {
+	case !slices.Contains(servedVersions, apiVersion):
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("CRD does not serve API version %q", apiVersion)
+	case storageVersion != apiVersion:
+		validated.State = report.Warning
+		validated.Description = fmt.Sprintf(
+			"CRD storage version does not match API version %q", apiVersion)
+	default:
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
+// validatedCRDSchema validates that the CRD version schema includes the fields read by ramenctl.
+// Fields missing in the schema are pruned by the API server, so ramenctl would read them as empty.
+func (c *Command) validatedCRDSchema(
+	name string,
+	version *ramen.CRDVersion,
+) *report.ValidatedBool {
+	validated := &report.ValidatedBool{}
+	if missing := version.MissingFields(name); len(missing) > 0 {
+		validated.State = report.Problem
+		validated.Description = fmt.Sprintf("CRD schema is missing fields %q", missing)
+	} else {
+		validated.Value = true
+		validated.State = report.OK
+	}
+	summary.AddValidation(c.Report.Summary, validated)
+	return validated
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/nirs/kubectl-gather/pkg/gather"
 	"github.com/ramendr/ramen/e2e/types"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -48,6 +49,14 @@ type Command struct {
 	// S3Results stores S3 operation results for validation.
 	S3Results []s3.Result
 
+	// GatherStarted and GatherFinished are the local times when gathering data from the clusters
+	// started and finished.
+	GatherStarted  time.Time
+	GatherFinished time.Time
+
+	// GatherFailed are the names of the clusters we failed to gather data from.
+	GatherFailed []string
+
 	cmd            *basecmd.Command
 	config         *config.Config
 	ctx            context.Context
@@ -78,6 +87,10 @@ func (c *Command) Env() *types.Env {
 	return c.cmd.Env()
 }
 
+func (c *Command) ManagedClusters() []*types.Cluster {
+	return c.cmd.ManagedClusters()
+}
+
 func (c *Command) Config() *config.Config {
 	return c.config
 }
@@ -117,7 +130,7 @@ func (c *Command) ValidateConfig() bool {
 	return true
 }
 
-func (c *Command) ValidatedDeleted(obj client.Object) report.ValidatedBool {
+func (c *Command) ValidatedDeleted(obj metav1.Object) report.ValidatedBool {
 	validated := report.ValidatedBool{}
 	if obj == nil {
 		validated.Value = true
@@ -150,17 +163,42 @@ func (c *Command) ValidatedConditions(
 	return validatedConditions
 }
 
+// ValidatedRequiredCondition validates a condition that must exist with the expected status. Use
+// for conditions that do not set observedGeneration, such as OCM conditions.
+func (c *Command) ValidatedRequiredCondition(
+	conditions []metav1.Condition,
+	conditionType string,
+	expectedStatus metav1.ConditionStatus,
+) report.ValidatedCondition {
+	validated := report.ValidatedCondition{Type: conditionType}
+
+	condition := meta.FindStatusCondition(conditions, conditionType)
+	switch {
+	case condition == nil:
+		validated.State = report.Problem
+		validated.Description = "Condition is missing"
+	case condition.Status != expectedStatus:
+		validated.State = report.Problem
+		validated.Description = condition.Message
+	default:
+		validated.State = report.OK
+	}
+
+	summary.AddValidation(c.Report.Summary, &validated)
+	return validated
+}
+
 // Gathering data.
 
-func (c *Command) GatherNamespaces(options gathering.Options) bool {
+// GatherNamespaces gathers data from clusters.
+func (c *Command) GatherNamespaces(clusters []*types.Cluster, options gathering.Options) bool {
 	start := time.Now()
-	env := c.Env()
-	clusters := []*types.Cluster{env.Hub, env.C1, env.C2}
 
 	c.Logger().Infof("Gathering from clusters %q with options %+v",
 		logging.ClusterNames(clusters), options)
 
 	var failedClusters []string
+	c.GatherStarted = start
 	for r := range c.Backend.Gather(c, clusters, options) {
 		step := &report.Step{Name: fmt.Sprintf("gather %q", r.Name), Duration: r.Duration}
 		if r.Err != nil {
@@ -177,7 +215,10 @@ func (c *Command) GatherNamespaces(options gathering.Options) bool {
 		c.Current.AddStep(step)
 	}
 
-	c.Logger().Infof("Gathered clusters in %.2f seconds", time.Since(start).Seconds())
+	c.GatherFinished = time.Now()
+	c.GatherFailed = failedClusters
+	c.Logger().Infof("Gathered clusters in %.2f seconds",
+		c.GatherFinished.Sub(start).Seconds())
 
 	switch c.Current.Status {
 	case report.Canceled:
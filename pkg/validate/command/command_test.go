@@ -73,6 +73,60 @@ func TestValidatedDeleted(t *testing.T) {
 	})
 }
 
+func TestValidatedRequiredCondition(t *testing.T) {
+	cmd := testCommand(t)
+	conditions := []metav1.Condition{
+		{Type: "Available", Status: metav1.ConditionTrue},
+		{Type: "Degraded", Status: metav1.ConditionTrue, Message: "degraded"},
+	}
+
+	t.Run("expected status", func(t *testing.T) {
+		validated := cmd.ValidatedRequiredCondition(conditions, "Available", metav1.ConditionTrue)
+		expected := report.ValidatedCondition{
+			Validated: report.Validated{
+				State: report.OK,
+			},
+			Type: "Available",
+		}
+		if validated != expected {
+			t.Fatalf("expected %v, got %v", expected, validated)
+		}
+	})
+	t.Run("unexpected status", func(t *testing.T) {
+		validated := cmd.ValidatedRequiredCondition(conditions, "Degraded", metav1.ConditionFalse)
+		expected := report.ValidatedCondition{
+			Validated: report.Validated{
+				State:       report.Problem,
+				Description: "degraded",
+			},
+			Type: "Degraded",
+		}
+		if validated != expected {
+			t.Fatalf("expected %v, got %v", expected, validated)
+		}
+	})
+	t.Run("missing condition", func(t *testing.T) {
+		validated := cmd.ValidatedRequiredCondition(conditions, "Joined", metav1.ConditionTrue)
+		expected := report.ValidatedCondition{
+			Validated: report.Validated{
+				State:       report.Problem,
+				Description: "Condition is missing",
+			},
+			Type: "Joined",
+		}
+		if validated != expected {
+			t.Fatalf("expected %v, got %v", expected, validated)
+		}
+	})
+
+	t.Run("update summary", func(t *testing.T) {
+		expected := report.Summary{summary.OK: 1, summary.Problem: 2}
+		if !cmd.Report.Summary.Equal(&expected) {
+			t.Fatalf("expected summary %v, got %v", expected, *cmd.Report.Summary)
+		}
+	})
+}
+
 // Helpers.
 
 func testCommand(t *testing.T) *Command {
@@ -12,7 +12,7 @@ import (
 	"github.com/ramendr/ramenctl/pkg/report"
 )
 
-func IsDeleted(obj client.Object) bool {
+func IsDeleted(obj metav1.Object) bool {
 	return !obj.GetDeletionTimestamp().IsZero()
 }
 
@@ -13,7 +13,7 @@ import (
 )
 
 func Clusters(opts command.Options) error {
-	cfg, err := config.ReadConfig(opts.ConfigFile)
+	cfg, err := config.ReadConfig(opts.ConfigFile, opts.Environment)
 	if err != nil {
 		return console.Failed(err)
 	}
@@ -39,7 +39,11 @@ func Clusters(opts command.Options) error {
 }
 
 func Application(opts command.ApplicationOptions) error {
-	cfg, err := config.ReadConfig(opts.ConfigFile)
+	if err := application.ValidateReadinessAction(opts.ReadinessAction); err != nil {
+		return console.Failed(err)
+	}
+
+	cfg, err := config.ReadConfig(opts.ConfigFile, opts.Environment)
 	if err != nil {
 		return console.Failed(err)
 	}
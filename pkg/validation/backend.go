@@ -26,6 +26,9 @@ func (b Backend) Validate(ctx Context) error {
 	if err := validateClusterset(ctx); err != nil {
 		return err
 	}
+	if err := discoverOperators(ctx); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -42,6 +45,23 @@ func (b Backend) ApplicationNamespaces(
 	return ramen.ApplicationNamespaces(drpc), nil
 }
 
+// ApplicationClusters inspects the application DRPC and returns the names of the managed clusters
+// in the DRPC DRPolicy.
+func (b Backend) ApplicationClusters(
+	ctx Context,
+	drpcName, drpcNamespace string,
+) ([]string, error) {
+	drpc, err := ramen.GetDRPC(ctx, drpcName, drpcNamespace)
+	if err != nil {
+		return nil, err
+	}
+	drPolicy, err := ramen.GetDRPolicy(ctx, drpc.Spec.DRPolicyRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	return drPolicy.Spec.DRClusters, nil
+}
+
 func (b Backend) Gather(
 	ctx Context,
 	clusters []*types.Cluster,
@@ -68,10 +88,20 @@ func (b Backend) GatherS3(
 	profiles []*s3.Profile,
 	prefixes []string,
 	outputDir string,
+	options s3.GatherOptions,
 ) <-chan s3.Result {
-	return s3.Gather(ctx.Context(), profiles, prefixes, outputDir, ctx.Logger())
+	setTransport(ctx, profiles)
+	return s3.Gather(ctx.Context(), profiles, prefixes, outputDir, options, ctx.Logger())
 }
 
 func (b Backend) CheckS3(ctx Context, profiles []*s3.Profile) <-chan s3.Result {
+	setTransport(ctx, profiles)
 	return s3.Check(ctx.Context(), profiles, ctx.Logger())
 }
+
+// setTransport sets the transport configured for each profile.
+func setTransport(ctx Context, profiles []*s3.Profile) {
+	for _, profile := range profiles {
+		profile.Transport = ctx.Config().S3.Transport(profile.Name)
+	}
+}
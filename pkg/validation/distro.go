@@ -25,7 +25,7 @@ func detectDistro(ctx Context) error {
 		return nil
 	}
 
-	clusters := []*types.Cluster{env.Hub, env.C1, env.C2}
+	clusters := append([]*types.Cluster{env.Hub}, ctx.ManagedClusters()...)
 	if env.PassiveHub != nil {
 		clusters = append(clusters, env.PassiveHub)
 	}
@@ -10,10 +10,11 @@ import (
 	ocmv1 "open-cluster-management.io/api/cluster/v1"
 	ocmv1b2 "open-cluster-management.io/api/cluster/v1beta2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ramendr/ramenctl/pkg/logging"
 )
 
 func validateClusterset(ctx Context) error {
-	env := ctx.Env()
 	cfg := ctx.Config()
 	log := ctx.Logger()
 	if _, err := getClusterSet(ctx, cfg.ClusterSet); err != nil {
@@ -23,7 +24,7 @@ func validateClusterset(ctx Context) error {
 	if err != nil {
 		return err
 	}
-	clusters := env.ManagedClusters()
+	clusters := ctx.ManagedClusters()
 	for _, cluster := range clusters {
 		if !slices.Contains(clusterNames, cluster.Name) {
 			return fmt.Errorf(
@@ -35,9 +36,8 @@ func validateClusterset(ctx Context) error {
 		}
 	}
 	log.Infof(
-		"Validated clusters [%q, %q] in clusterSet %q",
-		clusters[0].Name,
-		clusters[1].Name,
+		"Validated clusters %q in clusterSet %q",
+		logging.ClusterNames(clusters),
 		cfg.ClusterSet,
 	)
 	return nil
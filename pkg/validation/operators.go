@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	ramenapi "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/e2e/types"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ramendr/ramenctl/pkg/config"
+	"github.com/ramendr/ramenctl/pkg/ramen"
+)
+
+// discoverOperators discovers the ramen operator deployments on the clusters and sets
+// config.Operators. Operators are discovered by the ramen deployment labels, or by the OLM
+// operator owning the ramen CRDs. If an operator is not discovered, or discovery failed (e.g. we
+// are not allowed to list deployments in all namespaces), the default operator is used.
+func discoverOperators(ctx Context) error {
+	cfg := ctx.Config()
+	env := ctx.Env()
+	log := ctx.Logger()
+
+	type clusterType struct {
+		cluster        *types.Cluster
+		controllerType ramenapi.ControllerType
+	}
+
+	clusters := []clusterType{{env.Hub, ramenapi.DRHubType}}
+	if env.PassiveHub != nil {
+		clusters = append(clusters, clusterType{env.PassiveHub, ramenapi.DRHubType})
+	}
+	for _, cluster := range ctx.ManagedClusters() {
+		clusters = append(clusters, clusterType{cluster, ramenapi.DRClusterType})
+	}
+
+	operators := map[string]config.Operator{}
+	for _, ct := range clusters {
+		operator, err := discoverOperator(ctx, ct.cluster, ct.controllerType)
+		if err != nil {
+			log.Warnf("Failed to discover ramen operator on cluster %q: %s", ct.cluster.Name, err)
+			operator, err = readDefaultOperator(ctx, ct.cluster, ct.controllerType)
+			if err != nil {
+				return fmt.Errorf("failed to discover ramen operator on cluster %q: %w",
+					ct.cluster.Name, err)
+			}
+			log.Infof("Using default ramen operator on cluster %q: %+v",
+				ct.cluster.Name, *operator)
+		} else if operator == nil {
+			defaultOperator := ramen.DefaultOperator(cfg, ct.controllerType)
+			operator = &defaultOperator
+			log.Infof("Ramen operator not discovered on cluster %q, using default %+v",
+				ct.cluster.Name, *operator)
+		} else {
+			log.Infof("Discovered ramen operator on cluster %q: %+v", ct.cluster.Name, *operator)
+		}
+		operators[ct.cluster.Name] = *operator
+	}
+
+	cfg.Operators = operators
+
+	return nil
+}
+
+// discoverOperator returns the operator discovered on the cluster, or nil if the operator was not
+// found.
+func discoverOperator(
+	ctx Context,
+	cluster *types.Cluster,
+	controllerType ramenapi.ControllerType,
+) (*config.Operator, error) {
+	labels := client.MatchingLabels(ramen.OperatorLabels(controllerType))
+	deployment, err := findDeployment(ctx, cluster, labels)
+	if err != nil {
+		return nil, err
+	}
+	if deployment != nil {
+		operator := ramen.OperatorFromDeployment(
+			deployment,
+			controllerType,
+			ramen.OperatorDiscoveredByLabel,
+		)
+		return &operator, nil
+	}
+
+	crd, err := getCRD(ctx, cluster, ramen.OperatorCRDName(controllerType))
+	if err != nil {
+		return nil, err
+	}
+	if crd == nil {
+		return nil, nil
+	}
+
+	for _, label := range ramen.OperatorOwnerLabels(crd.GetLabels()) {
+		deployment, err := findDeployment(ctx, cluster, client.HasLabels{label})
+		if err != nil {
+			return nil, err
+		}
+		if deployment != nil {
+			operator := ramen.OperatorFromDeployment(
+				deployment,
+				controllerType,
+				ramen.OperatorDiscoveredByCRDOwner,
+			)
+			return &operator, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// readDefaultOperator returns the default operator for the controller type, updated from the
+// default operator deployment if it exists. Unlike discovery, this reads a single deployment in
+// the default operator namespace.
+func readDefaultOperator(
+	ctx Context,
+	cluster *types.Cluster,
+	controllerType ramenapi.ControllerType,
+) (*config.Operator, error) {
+	operator := ramen.DefaultOperator(ctx.Config(), controllerType)
+	deployment := &appsv1.Deployment{}
+	key := client.ObjectKey{Namespace: operator.Namespace, Name: operator.Deployment}
+	if err := cluster.Client.Get(ctx.Context(), key, deployment); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return &operator, nil
+		}
+		return nil, fmt.Errorf("failed to get deployment %q: %w", key, err)
+	}
+	operator = ramen.OperatorFromDeployment(
+		deployment,
+		controllerType,
+		ramen.OperatorDiscoveredByDefault,
+	)
+	return &operator, nil
+}
+
+// findDeployment returns the ramen operator deployment matching the selector, or nil if no
+// deployment was found. An OLM operator may install more than one deployment, so only
+// deployments running the ramen manager container are considered.
+func findDeployment(
+	ctx Context,
+	cluster *types.Cluster,
+	selector client.ListOption,
+) (*appsv1.Deployment, error) {
+	list := &appsv1.DeploymentList{}
+	if err := cluster.Client.List(ctx.Context(), list, selector); err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	for i := range list.Items {
+		deployment := &list.Items[i]
+		if hasManagerContainer(deployment) {
+			return deployment, nil
+		}
+	}
+	return nil, nil
+}
+
+func hasManagerContainer(deployment *appsv1.Deployment) bool {
+	for i := range deployment.Spec.Template.Spec.Containers {
+		if deployment.Spec.Template.Spec.Containers[i].Name == ramen.ManagerContainerName {
+			return true
+		}
+	}
+	return false
+}
+
+// getCRD returns the CRD, or nil if the CRD is not installed.
+func getCRD(ctx Context, cluster *types.Cluster, name string) (*unstructured.Unstructured, error) {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "apiextensions.k8s.io",
+		Version: "v1",
+		Kind:    "CustomResourceDefinition",
+	})
+	key := client.ObjectKey{Name: name}
+	if err := cluster.Client.Get(ctx.Context(), key, crd); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get CRD %q: %w", name, err)
+	}
+	return crd, nil
+}
@@ -23,6 +23,7 @@ type Context interface {
 type Validation interface {
 	Validate(ctx Context) error
 	ApplicationNamespaces(ctx Context, drpcName, drpcNamespace string) ([]string, error)
+	ApplicationClusters(ctx Context, drpcName, drpcNamespace string) ([]string, error)
 	Gather(
 		ctx Context,
 		clusters []*types.Cluster,
@@ -34,6 +35,7 @@ type Validation interface {
 		profiles []*s3.Profile,
 		prefixes []string,
 		outputDir string,
+		options s3.GatherOptions,
 	) <-chan s3.Result
 	CheckS3(ctx Context, profiles []*s3.Profile) <-chan s3.Result
 }
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ramendr/ramenctl/pkg/gathering"
+)
+
+const (
+	// ReplicationSourceKind and ReplicationDestinationKind are the kinds of the volsync
+	// replication resources.
+	ReplicationSourceKind      = "ReplicationSource"
+	ReplicationDestinationKind = "ReplicationDestination"
+
+	// We don't depend on volsync apis, the resources are parsed to Replication.
+	group                          = "volsync.backube"
+	replicationSourceResource      = group + "/replicationsources"
+	replicationDestinationResource = group + "/replicationdestinations"
+)
+
+// Replication is the part of ReplicationSource or ReplicationDestination used to validate the
+// replication of protected PVCs. Ramen names both resources after the protected PVC.
+type Replication struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              ReplicationSpec   `json:"spec"`
+	Status            ReplicationStatus `json:"status"`
+}
+
+// ReplicationSpec is the spec of a ReplicationSource or ReplicationDestination.
+type ReplicationSpec struct {
+	RsyncTLS *RsyncTLSSpec `json:"rsyncTLS,omitempty"`
+}
+
+// RsyncTLSSpec is the rsync-tls mover spec used by ramen.
+type RsyncTLSSpec struct {
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+}
+
+// ReplicationStatus is the status of a ReplicationSource or ReplicationDestination.
+type ReplicationStatus struct {
+	LastSyncTime     *metav1.Time       `json:"lastSyncTime,omitempty"`
+	LastSyncDuration *metav1.Duration   `json:"lastSyncDuration,omitempty"`
+	Conditions       []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ListReplicationSources lists replication sources in namespace from the output directory.
+func ListReplicationSources(reader gathering.OutputReader, namespace string) ([]string, error) {
+	return reader.ListResources(namespace, replicationSourceResource)
+}
+
+// ListReplicationDestinations lists replication destinations in namespace from the output
+// directory.
+func ListReplicationDestinations(
+	reader gathering.OutputReader,
+	namespace string,
+) ([]string, error) {
+	return reader.ListResources(namespace, replicationDestinationResource)
+}
+
+// ReadReplicationSource reads a replication source from the output directory.
+func ReadReplicationSource(
+	reader gathering.OutputReader,
+	name, namespace string,
+) (*Replication, error) {
+	return readReplication(reader, replicationSourceResource, name, namespace)
+}
+
+// ReadReplicationDestination reads a replication destination from the output directory.
+func ReadReplicationDestination(
+	reader gathering.OutputReader,
+	name, namespace string,
+) (*Replication, error) {
+	return readReplication(reader, replicationDestinationResource, name, namespace)
+}
+
+func readReplication(
+	reader gathering.OutputReader,
+	resource, name, namespace string,
+) (*Replication, error) {
+	data, err := reader.ReadResource(namespace, resource, name)
+	if err != nil {
+		return nil, err
+	}
+	replication := &Replication{}
+	if err := yaml.Unmarshal(data, replication); err != nil {
+		return nil, err
+	}
+	return replication, nil
+}